@@ -119,6 +119,10 @@ var Pi_dhcp_id string
 var PiCloudConnectionName string
 var PiSAPProfileID string
 var Pi_placement_group_name string
+var Pi_volume_group_id string
+var Pi_workspace_datacenter string
+var Pi_shared_processor_pool_id string
+var Pi_spp_placement_group_id string
 var PiStoragePool string
 var PiStorageType string
 
@@ -660,6 +664,27 @@ func init() {
 		Pi_placement_group_name = "tf-pi-placement-group"
 		fmt.Println("[WARN] Set the environment variable PI_PLACEMENT_GROUP_NAME for testing ibm_pi_placement_group resource else it is set to default value 'tf-pi-placement-group'")
 	}
+
+	Pi_volume_group_id = os.Getenv("PI_VOLUME_GROUP_ID")
+	if Pi_volume_group_id == "" {
+		Pi_volume_group_id = "terraform-test-power"
+		fmt.Println("[INFO] Set the environment variable PI_VOLUME_GROUP_ID for testing ibm_pi_volume_group data sources else it is set to default value 'terraform-test-power'")
+	}
+	Pi_workspace_datacenter = os.Getenv("PI_WORKSPACE_DATACENTER")
+	if Pi_workspace_datacenter == "" {
+		Pi_workspace_datacenter = "dal12"
+		fmt.Println("[INFO] Set the environment variable PI_WORKSPACE_DATACENTER for testing ibm_pi_workspace resource else it is set to default value 'dal12'")
+	}
+	Pi_shared_processor_pool_id = os.Getenv("PI_SHARED_PROCESSOR_POOL_ID")
+	if Pi_shared_processor_pool_id == "" {
+		Pi_shared_processor_pool_id = "tf-pi-shared-processor-pool"
+		fmt.Println("[INFO] Set the environment variable PI_SHARED_PROCESSOR_POOL_ID for testing ibm_pi_shared_processor_pool data source else it is set to default value 'tf-pi-shared-processor-pool'")
+	}
+	Pi_spp_placement_group_id = os.Getenv("PI_SPP_PLACEMENT_GROUP_ID")
+	if Pi_spp_placement_group_id == "" {
+		Pi_spp_placement_group_id = "tf-pi-spp-placement-group"
+		fmt.Println("[INFO] Set the environment variable PI_SPP_PLACEMENT_GROUP_ID for testing ibm_pi_spp_placement_group data source else it is set to default value 'tf-pi-spp-placement-group'")
+	}
 	PiStoragePool = os.Getenv("PI_STORAGE_POOL")
 	if PiStoragePool == "" {
 		PiStoragePool = "terraform-test-power"
@@ -5,8 +5,10 @@ package flex
 
 import (
 	"crypto/hmac"
+	"crypto/rand"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"log"
 	"reflect"
 	"regexp"
@@ -62,3 +64,65 @@ func SuppressHashedRawSecret(k, old, new string, d *schema.ResourceData) bool {
 	secureHmac := hex.EncodeToString(mac.Sum(nil))
 	return cmp.Equal(strings.Join([]string{"hash", "SHA3-512", secureHmac}, ":"), old)
 }
+
+// HashSecret returns a salted hash of a write-only secret. The hash is stored in state instead of the secret.
+func HashSecret(secret string) (string, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("[ERROR] Error generating the salt of a secret hash: %s", err)
+	}
+	return saltedSecretHash(hex.EncodeToString(salt), secret), nil
+}
+
+func saltedSecretHash(salt, secret string) string {
+	mac := hmac.New(sha3.New512, []byte(salt))
+	mac.Write([]byte(secret))
+	return strings.Join([]string{"hash", "SHA3-512", salt, hex.EncodeToString(mac.Sum(nil))}, ":")
+}
+
+// IsHashedSecret returns true when the value is a hash generated by HashSecret
+func IsHashedSecret(value string) bool {
+	parts := strings.Split(value, ":")
+	return len(parts) == 4 && parts[0] == "hash" && parts[1] == "SHA3-512"
+}
+
+// SecretMatches returns true when the secret is the value in state, or the secret the hash in state was generated from
+func SecretMatches(stateValue, secret string) bool {
+	if !IsHashedSecret(stateValue) {
+		return stateValue == secret
+	}
+	salt := strings.Split(stateValue, ":")[2]
+	return hmac.Equal([]byte(saltedSecretHash(salt, secret)), []byte(stateValue))
+}
+
+// HashedSecretState returns the value to store in state for a write-only secret, the hash in state is kept while it matches the secret
+func HashedSecretState(stateValue, secret string) (string, error) {
+	if secret == "" {
+		return "", nil
+	}
+	if IsHashedSecret(stateValue) && SecretMatches(stateValue, secret) {
+		return stateValue, nil
+	}
+	return HashSecret(secret)
+}
+
+// SuppressSaltedHashedSecret suppresses the diff of a write-only secret while the configured secret matches the hash in state
+func SuppressSaltedHashedSecret(k, old, new string, d *schema.ResourceData) bool {
+	if !IsHashedSecret(old) {
+		return false
+	}
+	return SecretMatches(old, new)
+}
+
+// SetHashedSecret replaces a write-only secret in state with its hash
+func SetHashedSecret(d *schema.ResourceData, key string) error {
+	secret := d.Get(key).(string)
+	if secret == "" || IsHashedSecret(secret) {
+		return nil
+	}
+	hash, err := HashSecret(secret)
+	if err != nil {
+		return err
+	}
+	return d.Set(key, hash)
+}
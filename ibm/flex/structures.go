@@ -25,6 +25,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/ibm-cos-sdk-go-config/resourceconfigurationv1"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	kp "github.com/IBM/keyprotect-go-client"
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
@@ -872,6 +873,173 @@ func FlattenCosObejctVersioning(in *s3.GetBucketVersioningOutput) []interface{}
 	return versioning
 }
 
+func FlattenCosObjectLockConfiguration(in *s3.ObjectLockConfiguration) []interface{} {
+	objectLock := make([]interface{}, 0, 1)
+	if in == nil || in.ObjectLockEnabled == nil {
+		return objectLock
+	}
+	att := map[string]interface{}{
+		"object_lock_enabled": aws.StringValue(in.ObjectLockEnabled),
+	}
+	if in.Rule != nil && in.Rule.DefaultRetention != nil {
+		retention := map[string]interface{}{
+			"mode": aws.StringValue(in.Rule.DefaultRetention.Mode),
+		}
+		if in.Rule.DefaultRetention.Days != nil {
+			retention["days"] = int(*in.Rule.DefaultRetention.Days)
+		}
+		if in.Rule.DefaultRetention.Years != nil {
+			retention["years"] = int(*in.Rule.DefaultRetention.Years)
+		}
+		att["object_lock_rule"] = []interface{}{
+			map[string]interface{}{
+				"default_retention": []interface{}{retention},
+			},
+		}
+	}
+	objectLock = append(objectLock, att)
+	return objectLock
+}
+
+func FlattenCosWebsiteConfiguration(in *s3.GetBucketWebsiteOutput) []interface{} {
+	website := make([]interface{}, 0, 1)
+	if in == nil || (in.IndexDocument == nil && in.ErrorDocument == nil && in.RedirectAllRequestsTo == nil && len(in.RoutingRules) == 0) {
+		return website
+	}
+	att := make(map[string]interface{})
+	if in.IndexDocument != nil {
+		att["index_document"] = aws.StringValue(in.IndexDocument.Suffix)
+	}
+	if in.ErrorDocument != nil {
+		att["error_document"] = aws.StringValue(in.ErrorDocument.Key)
+	}
+	if in.RedirectAllRequestsTo != nil {
+		att["redirect_all_requests_to"] = []interface{}{
+			map[string]interface{}{
+				"host_name": aws.StringValue(in.RedirectAllRequestsTo.HostName),
+				"protocol":  aws.StringValue(in.RedirectAllRequestsTo.Protocol),
+			},
+		}
+	}
+	routingRules := make([]interface{}, 0, len(in.RoutingRules))
+	for _, r := range in.RoutingRules {
+		rule := make(map[string]interface{})
+		if r.Condition != nil {
+			rule["condition"] = []interface{}{
+				map[string]interface{}{
+					"http_error_code_returned_equals": aws.StringValue(r.Condition.HttpErrorCodeReturnedEquals),
+					"key_prefix_equals":               aws.StringValue(r.Condition.KeyPrefixEquals),
+				},
+			}
+		}
+		if r.Redirect != nil {
+			rule["redirect"] = []interface{}{
+				map[string]interface{}{
+					"host_name":               aws.StringValue(r.Redirect.HostName),
+					"http_redirect_code":      aws.StringValue(r.Redirect.HttpRedirectCode),
+					"protocol":                aws.StringValue(r.Redirect.Protocol),
+					"replace_key_prefix_with": aws.StringValue(r.Redirect.ReplaceKeyPrefixWith),
+					"replace_key_with":        aws.StringValue(r.Redirect.ReplaceKeyWith),
+				},
+			}
+		}
+		routingRules = append(routingRules, rule)
+	}
+	att["routing_rule"] = routingRules
+	website = append(website, att)
+	return website
+}
+
+func FlattenCosCORSRules(in []*s3.CORSRule) []interface{} {
+	rules := make([]interface{}, 0, len(in))
+	for _, r := range in {
+		rule := map[string]interface{}{
+			"allowed_headers": aws.StringValueSlice(r.AllowedHeaders),
+			"allowed_methods": aws.StringValueSlice(r.AllowedMethods),
+			"allowed_origins": aws.StringValueSlice(r.AllowedOrigins),
+			"expose_headers":  aws.StringValueSlice(r.ExposeHeaders),
+		}
+		if r.MaxAgeSeconds != nil {
+			rule["max_age_seconds"] = int(*r.MaxAgeSeconds)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// CosPublicReadGranted reports whether the bucket ACL grants read access to all users.
+func CosPublicReadGranted(grants []*s3.Grant) bool {
+	for _, g := range grants {
+		if g.Grantee != nil && aws.StringValue(g.Grantee.URI) == "http://acs.amazonaws.com/groups/global/AllUsers" &&
+			(aws.StringValue(g.Permission) == s3.PermissionRead || aws.StringValue(g.Permission) == s3.PermissionFullControl) {
+			return true
+		}
+	}
+	return false
+}
+
+func FlattenCosLifecycleRules(in []*s3.LifecycleRule) []interface{} {
+	rules := make([]interface{}, 0, len(in))
+	for _, r := range in {
+		rule := map[string]interface{}{
+			"rule_id": aws.StringValue(r.ID),
+			"status":  aws.StringValue(r.Status),
+		}
+		if r.Filter != nil && r.Filter.Prefix != nil && *r.Filter.Prefix != "" {
+			rule["filter"] = []interface{}{
+				map[string]interface{}{
+					"prefix": *r.Filter.Prefix,
+				},
+			}
+		}
+		if r.Expiration != nil {
+			expiration := make(map[string]interface{})
+			if r.Expiration.Date != nil {
+				expiration["date"] = r.Expiration.Date.UTC().Format("2006-01-02")
+			}
+			if r.Expiration.Days != nil && *r.Expiration.Days > 0 {
+				expiration["days"] = int(*r.Expiration.Days)
+			}
+			if r.Expiration.ExpiredObjectDeleteMarker != nil {
+				expiration["expired_object_delete_marker"] = *r.Expiration.ExpiredObjectDeleteMarker
+			}
+			rule["expiration"] = []interface{}{expiration}
+		}
+		transitions := make([]interface{}, 0, len(r.Transitions))
+		for _, transition := range r.Transitions {
+			att := map[string]interface{}{
+				"storage_class": aws.StringValue(transition.StorageClass),
+			}
+			if transition.Date != nil {
+				att["date"] = transition.Date.UTC().Format("2006-01-02")
+			}
+			if transition.Days != nil {
+				att["days"] = int(*transition.Days)
+			}
+			transitions = append(transitions, att)
+		}
+		if len(transitions) > 0 {
+			rule["transition"] = transitions
+		}
+		if r.NoncurrentVersionExpiration != nil && r.NoncurrentVersionExpiration.NoncurrentDays != nil {
+			rule["noncurrent_version_expiration"] = []interface{}{
+				map[string]interface{}{
+					"noncurrent_days": int(*r.NoncurrentVersionExpiration.NoncurrentDays),
+				},
+			}
+		}
+		if r.AbortIncompleteMultipartUpload != nil && r.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
+			rule["abort_incomplete_multipart_upload"] = []interface{}{
+				map[string]interface{}{
+					"days_after_initiation": int(*r.AbortIncompleteMultipartUpload.DaysAfterInitiation),
+				},
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
 func ReplicationRuleGet(in *s3.ReplicationConfiguration) []map[string]interface{} {
 	rules := make([]map[string]interface{}, 0, 1)
 	if in != nil {
@@ -3056,6 +3224,384 @@ func SetTags(d *schema.ResourceData) []iampolicymanagementv1.ResourceTag {
 	return []iampolicymanagementv1.ResourceTag{}
 }
 
+// V2PolicyAttribute is a subject or resource attribute of a v2 policy
+type V2PolicyAttribute struct {
+	Key      string      `json:"key"`
+	Operator string      `json:"operator,omitempty"`
+	Value    interface{} `json:"value"`
+}
+
+// V2PolicyRule is the rule of a v2 policy, either a single condition or conditions joined by an operator
+type V2PolicyRule struct {
+	Key        string         `json:"key,omitempty"`
+	Operator   string         `json:"operator,omitempty"`
+	Value      interface{}    `json:"value,omitempty"`
+	Conditions []V2PolicyRule `json:"conditions,omitempty"`
+}
+
+type V2PolicySubject struct {
+	Attributes []V2PolicyAttribute `json:"attributes"`
+}
+
+type V2PolicyResource struct {
+	Attributes []V2PolicyAttribute `json:"attributes"`
+	Tags       []V2PolicyAttribute `json:"tags,omitempty"`
+}
+
+type V2PolicyRole struct {
+	RoleID string `json:"role_id"`
+}
+
+type V2PolicyGrant struct {
+	Roles []V2PolicyRole `json:"roles"`
+}
+
+type V2PolicyControl struct {
+	Grant V2PolicyGrant `json:"grant"`
+}
+
+// V2Policy is a policy of the IAM Policy Management v2 API, which the platform services SDK does not provide
+type V2Policy struct {
+	ID          string           `json:"id,omitempty"`
+	Type        string           `json:"type"`
+	Description string           `json:"description,omitempty"`
+	Subject     V2PolicySubject  `json:"subject"`
+	Resource    V2PolicyResource `json:"resource"`
+	Control     V2PolicyControl  `json:"control"`
+	Rule        *V2PolicyRule    `json:"rule,omitempty"`
+	Pattern     string           `json:"pattern,omitempty"`
+	State       string           `json:"state,omitempty"`
+}
+
+// IsV2Policy returns true when the policy has rule conditions, either in the configuration or in the state.
+// Such policies are managed with the v2 API.
+func IsV2Policy(d *schema.ResourceData) bool {
+	if _, ok := d.GetOk("rule_conditions"); ok {
+		return true
+	}
+	old, _ := d.GetChange("rule_conditions")
+	if conditions, ok := old.(*schema.Set); ok && conditions.Len() > 0 {
+		return true
+	}
+	return false
+}
+
+// GeneratePolicyRule returns the rule of the rule_conditions and rule_operator of the resource
+func GeneratePolicyRule(d *schema.ResourceData) *V2PolicyRule {
+	conditions := []V2PolicyRule{}
+	for _, c := range d.Get("rule_conditions").(*schema.Set).List() {
+		condition := c.(map[string]interface{})
+		operator := condition["operator"].(string)
+		values := ExpandStringList(condition["value"].([]interface{}))
+		var value interface{} = values
+		if len(values) == 1 && !strings.HasSuffix(operator, "AnyOf") {
+			value = values[0]
+		}
+		conditions = append(conditions, V2PolicyRule{
+			Key:      condition["key"].(string),
+			Operator: operator,
+			Value:    value,
+		})
+	}
+	if len(conditions) == 0 {
+		return nil
+	}
+	if len(conditions) == 1 {
+		return &conditions[0]
+	}
+	ruleOperator := d.Get("rule_operator").(string)
+	if ruleOperator == "" {
+		ruleOperator = "and"
+	}
+	return &V2PolicyRule{
+		Operator:   ruleOperator,
+		Conditions: conditions,
+	}
+}
+
+// GenerateV2Policy returns the v2 access policy of the subject, resource and roles built for the v1 API
+func GenerateV2Policy(d *schema.ResourceData, subject iampolicymanagementv1.PolicySubject, resource iampolicymanagementv1.PolicyResource, roles []iampolicymanagementv1.PolicyRole) V2Policy {
+	policy := V2Policy{
+		Type:    "access",
+		Rule:    GeneratePolicyRule(d),
+		Pattern: d.Get("pattern").(string),
+	}
+	if desc, ok := d.GetOk("description"); ok {
+		policy.Description = desc.(string)
+	}
+	for _, a := range subject.Attributes {
+		policy.Subject.Attributes = append(policy.Subject.Attributes, V2PolicyAttribute{
+			Key:      *a.Name,
+			Operator: "stringEquals",
+			Value:    *a.Value,
+		})
+	}
+	for _, a := range resource.Attributes {
+		operator := "stringEquals"
+		if a.Operator != nil {
+			operator = *a.Operator
+		}
+		policy.Resource.Attributes = append(policy.Resource.Attributes, V2PolicyAttribute{
+			Key:      *a.Name,
+			Operator: operator,
+			Value:    *a.Value,
+		})
+	}
+	for _, t := range resource.Tags {
+		policy.Resource.Tags = append(policy.Resource.Tags, V2PolicyAttribute{
+			Key:      *t.Name,
+			Operator: *t.Operator,
+			Value:    *t.Value,
+		})
+	}
+	for _, r := range roles {
+		policy.Control.Grant.Roles = append(policy.Control.Grant.Roles, V2PolicyRole{
+			RoleID: *r.RoleID,
+		})
+	}
+	return policy
+}
+
+func v2PolicyRequest(client *iampolicymanagementv1.IamPolicyManagementV1, method, policyID string, headers, query map[string]string, body, result interface{}) (*core.DetailedResponse, error) {
+	path := "/v2/policies"
+	pathParamsMap := map[string]string{}
+	if policyID != "" {
+		path = "/v2/policies/{policy_id}"
+		pathParamsMap["policy_id"] = policyID
+	}
+
+	builder := core.NewRequestBuilder(method)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(client.Service.Options.URL, path, pathParamsMap)
+	if err != nil {
+		return nil, err
+	}
+	for headerName, headerValue := range headers {
+		if headerValue != "" {
+			builder.AddHeader(headerName, headerValue)
+		}
+	}
+	for queryName, queryValue := range query {
+		if queryValue != "" {
+			builder.AddQuery(queryName, queryValue)
+		}
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		_, err = builder.SetBodyContentJSON(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return client.Service.Request(request, result)
+}
+
+// CreateV2Policy creates a policy with the v2 API
+func CreateV2Policy(client *iampolicymanagementv1.IamPolicyManagementV1, policy V2Policy, transactionID string) (*V2Policy, *core.DetailedResponse, error) {
+	result := &V2Policy{}
+	response, err := v2PolicyRequest(client, core.POST, "", map[string]string{"Transaction-Id": transactionID}, nil, policy, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// UpdateV2Policy replaces a policy with the v2 API
+func UpdateV2Policy(client *iampolicymanagementv1.IamPolicyManagementV1, policyID, etag string, policy V2Policy, transactionID string) (*V2Policy, *core.DetailedResponse, error) {
+	result := &V2Policy{}
+	response, err := v2PolicyRequest(client, core.PUT, policyID, map[string]string{"If-Match": etag, "Transaction-Id": transactionID}, nil, policy, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// GetV2Policy gets a policy with the v2 API
+func GetV2Policy(client *iampolicymanagementv1.IamPolicyManagementV1, policyID, transactionID string) (*V2Policy, *core.DetailedResponse, error) {
+	result := &V2Policy{}
+	response, err := v2PolicyRequest(client, core.GET, policyID, map[string]string{"Transaction-Id": transactionID}, nil, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// DeleteV2Policy deletes a policy with the v2 API
+func DeleteV2Policy(client *iampolicymanagementv1.IamPolicyManagementV1, policyID, transactionID string) (*core.DetailedResponse, error) {
+	return v2PolicyRequest(client, core.DELETE, policyID, map[string]string{"Transaction-Id": transactionID}, nil, nil, nil)
+}
+
+// ListV2Policies lists the policies that match the query with the v2 API, following the pages of the result
+func ListV2Policies(client *iampolicymanagementv1.IamPolicyManagementV1, query map[string]string, transactionID string) ([]V2Policy, *core.DetailedResponse, error) {
+	policies := []V2Policy{}
+	pageQuery := map[string]string{}
+	for k, v := range query {
+		pageQuery[k] = v
+	}
+	for {
+		result := &struct {
+			Policies []V2Policy `json:"policies"`
+			Next     *struct {
+				Start string `json:"start"`
+			} `json:"next"`
+		}{}
+		response, err := v2PolicyRequest(client, core.GET, "", map[string]string{"Transaction-Id": transactionID}, pageQuery, nil, result)
+		if err != nil {
+			return nil, response, err
+		}
+		policies = append(policies, result.Policies...)
+		if result.Next == nil || result.Next.Start == "" {
+			return policies, response, nil
+		}
+		pageQuery["start"] = result.Next.Start
+	}
+}
+
+// GetPolicy gets a policy with the v2 API when v2 is set and with the v1 API otherwise.
+// Policies read with the v2 API are returned as v1 policies together with the v2 policy that holds their rule.
+func GetPolicy(client *iampolicymanagementv1.IamPolicyManagementV1, getPolicyOptions *iampolicymanagementv1.GetPolicyOptions, v2 bool, meta interface{}) (*iampolicymanagementv1.Policy, *V2Policy, *core.DetailedResponse, error) {
+	if !v2 {
+		policy, response, err := client.GetPolicy(getPolicyOptions)
+		return policy, nil, response, err
+	}
+	v2Policy, response, err := GetV2Policy(client, *getPolicyOptions.PolicyID, getPolicyOptions.Headers["Transaction-Id"])
+	if err != nil {
+		return nil, nil, response, err
+	}
+	policy, err := convertV2Policy(client, v2Policy, meta)
+	if err != nil {
+		return nil, nil, response, err
+	}
+	return policy, v2Policy, response, nil
+}
+
+// convertV2Policy returns the v1 policy of a v2 policy. The v2 API only returns the role IDs,
+// the display names of the roles are looked up like GeneratePolicyOptions looks up the roles.
+func convertV2Policy(client *iampolicymanagementv1.IamPolicyManagementV1, v2Policy *V2Policy, meta interface{}) (*iampolicymanagementv1.Policy, error) {
+	policy := &iampolicymanagementv1.Policy{
+		ID:    core.StringPtr(v2Policy.ID),
+		Type:  core.StringPtr(v2Policy.Type),
+		State: core.StringPtr(v2Policy.State),
+	}
+	if v2Policy.Description != "" {
+		policy.Description = core.StringPtr(v2Policy.Description)
+	}
+
+	subject := iampolicymanagementv1.PolicySubject{}
+	for _, a := range v2Policy.Subject.Attributes {
+		subject.Attributes = append(subject.Attributes, iampolicymanagementv1.SubjectAttribute{
+			Name:  core.StringPtr(a.Key),
+			Value: core.StringPtr(fmt.Sprint(a.Value)),
+		})
+	}
+	policy.Subjects = []iampolicymanagementv1.PolicySubject{subject}
+
+	resource := iampolicymanagementv1.PolicyResource{}
+	for _, a := range v2Policy.Resource.Attributes {
+		resource.Attributes = append(resource.Attributes, iampolicymanagementv1.ResourceAttribute{
+			Name:     core.StringPtr(a.Key),
+			Value:    core.StringPtr(fmt.Sprint(a.Value)),
+			Operator: core.StringPtr(a.Operator),
+		})
+	}
+	for _, t := range v2Policy.Resource.Tags {
+		resource.Tags = append(resource.Tags, iampolicymanagementv1.ResourceTag{
+			Name:     core.StringPtr(t.Key),
+			Value:    core.StringPtr(fmt.Sprint(t.Value)),
+			Operator: core.StringPtr(t.Operator),
+		})
+	}
+	policy.Resources = []iampolicymanagementv1.PolicyResource{resource}
+
+	// the display name of the IAM platform and service roles is the last segment of their ID, other roles are listed
+	var roles []iampolicymanagementv1.PolicyRole
+	for _, r := range v2Policy.Control.Grant.Roles {
+		if strings.HasPrefix(r.RoleID, "crn:v1:bluemix:public:iam::::") {
+			continue
+		}
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return nil, err
+		}
+		serviceToQuery := *GetResourceAttribute("serviceName", resource)
+		if serviceToQuery == "" && *GetResourceAttribute("serviceType", resource) != "platform_service" {
+			serviceToQuery = "alliamserviceroles"
+		}
+		listRoleOptions := &iampolicymanagementv1.ListRolesOptions{
+			AccountID:   &userDetails.UserAccount,
+			ServiceName: &serviceToQuery,
+		}
+		roleList, response, err := client.ListRoles(listRoleOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing roles: %s\n%s", err, response)
+		}
+		roles = MapRoleListToPolicyRoles(*roleList)
+		break
+	}
+	for _, r := range v2Policy.Control.Grant.Roles {
+		displayName := r.RoleID[strings.LastIndex(r.RoleID, ":")+1:]
+		for _, role := range roles {
+			if role.RoleID != nil && *role.RoleID == r.RoleID && role.DisplayName != nil {
+				displayName = *role.DisplayName
+				break
+			}
+		}
+		policy.Roles = append(policy.Roles, iampolicymanagementv1.PolicyRole{
+			RoleID:      core.StringPtr(r.RoleID),
+			DisplayName: core.StringPtr(displayName),
+		})
+	}
+	return policy, nil
+}
+
+// FlattenPolicyRuleConditions returns the rule_conditions of a v2 policy rule
+func FlattenPolicyRuleConditions(rule *V2PolicyRule) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	if rule == nil {
+		return result
+	}
+	conditions := rule.Conditions
+	if len(conditions) == 0 {
+		conditions = []V2PolicyRule{*rule}
+	}
+	for _, condition := range conditions {
+		values := []string{}
+		switch value := condition.Value.(type) {
+		case []interface{}:
+			for _, v := range value {
+				values = append(values, fmt.Sprint(v))
+			}
+		case nil:
+		default:
+			values = append(values, fmt.Sprint(value))
+		}
+		result = append(result, map[string]interface{}{
+			"key":      condition.Key,
+			"operator": condition.Operator,
+			"value":    values,
+		})
+	}
+	return result
+}
+
+// SetPolicyRule sets the rule_conditions, rule_operator and pattern of a policy read with the v2 API
+func SetPolicyRule(d *schema.ResourceData, v2Policy *V2Policy) {
+	if v2Policy == nil {
+		return
+	}
+	d.Set("rule_conditions", FlattenPolicyRuleConditions(v2Policy.Rule))
+	if v2Policy.Rule != nil && len(v2Policy.Rule.Conditions) > 0 {
+		d.Set("rule_operator", v2Policy.Rule.Operator)
+	}
+	d.Set("pattern", v2Policy.Pattern)
+}
+
 func GetIBMUniqueId(accountID, userEmail string, meta interface{}) (string, error) {
 	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
 	if err != nil {
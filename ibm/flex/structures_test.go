@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+func TestFlattenCosLifecycleRules_transitions(t *testing.T) {
+	rules := FlattenCosLifecycleRules([]*s3.LifecycleRule{
+		{
+			ID:     aws.String("archive"),
+			Status: aws.String("Enabled"),
+			Transitions: []*s3.Transition{
+				{StorageClass: aws.String("ACCELERATED"), Days: aws.Int64(30)},
+				{StorageClass: aws.String("GLACIER"), Days: aws.Int64(90)},
+			},
+		},
+	})
+	if len(rules) != 1 {
+		t.Fatalf("bad: %d rules", len(rules))
+	}
+	expected := []interface{}{
+		map[string]interface{}{"storage_class": "ACCELERATED", "days": 30},
+		map[string]interface{}{"storage_class": "GLACIER", "days": 90},
+	}
+	actual := rules[0].(map[string]interface{})["transition"]
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v\n\t%#v", actual, expected)
+	}
+}
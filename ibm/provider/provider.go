@@ -331,8 +331,10 @@ func Provider() *schema.Provider {
 			"ibm_dns_secondary":                     classicinfrastructure.DataSourceIBMDNSSecondary(),
 			"ibm_event_streams_topic":               eventstreams.DataSourceIBMEventStreamsTopic(),
 			"ibm_event_streams_schema":              eventstreams.DataSourceIBMEventStreamsSchema(),
+			"ibm_event_streams_consumer_groups":     eventstreams.DataSourceIBMEventStreamsConsumerGroups(),
 			"ibm_hpcs":                              hpcs.DataSourceIBMHPCS(),
 			"ibm_hpcs_managed_key":                  hpcs.DataSourceIbmManagedKey(),
+			"ibm_hpcs_managed_key_distribution":     hpcs.DataSourceIbmManagedKeyDistribution(),
 			"ibm_hpcs_key_template":                 hpcs.DataSourceIbmKeyTemplate(),
 			"ibm_hpcs_keystore":                     hpcs.DataSourceIbmKeystore(),
 			"ibm_hpcs_vault":                        hpcs.DataSourceIbmVault(),
@@ -525,37 +527,45 @@ func Provider() *schema.Provider {
 
 			// // Added for Power Resources
 
-			"ibm_pi_catalog_images":         power.DataSourceIBMPICatalogImages(),
-			"ibm_pi_cloud_connection":       power.DataSourceIBMPICloudConnection(),
-			"ibm_pi_cloud_connections":      power.DataSourceIBMPICloudConnections(),
-			"ibm_pi_cloud_instance":         power.DataSourceIBMPICloudInstance(),
-			"ibm_pi_console_languages":      power.DataSourceIBMPIInstanceConsoleLanguages(),
-			"ibm_pi_dhcp":                   power.DataSourceIBMPIDhcp(),
-			"ibm_pi_dhcps":                  power.DataSourceIBMPIDhcps(),
-			"ibm_pi_image":                  power.DataSourceIBMPIImage(),
-			"ibm_pi_images":                 power.DataSourceIBMPIImages(),
-			"ibm_pi_instance":               power.DataSourceIBMPIInstance(),
-			"ibm_pi_instances":              power.DataSourceIBMPIInstances(),
-			"ibm_pi_instance_ip":            power.DataSourceIBMPIInstanceIP(),
-			"ibm_pi_instance_snapshots":     power.DataSourceIBMPISnapshots(),
-			"ibm_pi_instance_volumes":       power.DataSourceIBMPIInstanceVolumes(),
-			"ibm_pi_key":                    power.DataSourceIBMPIKey(),
-			"ibm_pi_keys":                   power.DataSourceIBMPIKeys(),
-			"ibm_pi_network":                power.DataSourceIBMPINetwork(),
-			"ibm_pi_network_port":           power.DataSourceIBMPINetworkPort(),
-			"ibm_pi_placement_group":        power.DataSourceIBMPIPlacementGroup(),
-			"ibm_pi_placement_groups":       power.DataSourceIBMPIPlacementGroups(),
-			"ibm_pi_public_network":         power.DataSourceIBMPIPublicNetwork(),
-			"ibm_pi_pvm_snapshots":          power.DataSourceIBMPISnapshot(),
-			"ibm_pi_sap_profile":            power.DataSourceIBMPISAPProfile(),
-			"ibm_pi_sap_profiles":           power.DataSourceIBMPISAPProfiles(),
-			"ibm_pi_storage_pool_capacity":  power.DataSourceIBMPIStoragePoolCapacity(),
-			"ibm_pi_storage_pools_capacity": power.DataSourceIBMPIStoragePoolsCapacity(),
-			"ibm_pi_storage_type_capacity":  power.DataSourceIBMPIStorageTypeCapacity(),
-			"ibm_pi_storage_types_capacity": power.DataSourceIBMPIStorageTypesCapacity(),
-			"ibm_pi_system_pools":           power.DataSourceIBMPISystemPools(),
-			"ibm_pi_tenant":                 power.DataSourceIBMPITenant(),
-			"ibm_pi_volume":                 power.DataSourceIBMPIVolume(),
+			"ibm_pi_catalog_images":                         power.DataSourceIBMPICatalogImages(),
+			"ibm_pi_cloud_connection":                       power.DataSourceIBMPICloudConnection(),
+			"ibm_pi_cloud_connections":                      power.DataSourceIBMPICloudConnections(),
+			"ibm_pi_cloud_instance":                         power.DataSourceIBMPICloudInstance(),
+			"ibm_pi_console_languages":                      power.DataSourceIBMPIInstanceConsoleLanguages(),
+			"ibm_pi_dhcp":                                   power.DataSourceIBMPIDhcp(),
+			"ibm_pi_dhcps":                                  power.DataSourceIBMPIDhcps(),
+			"ibm_pi_image":                                  power.DataSourceIBMPIImage(),
+			"ibm_pi_images":                                 power.DataSourceIBMPIImages(),
+			"ibm_pi_instance":                               power.DataSourceIBMPIInstance(),
+			"ibm_pi_instances":                              power.DataSourceIBMPIInstances(),
+			"ibm_pi_instance_ip":                            power.DataSourceIBMPIInstanceIP(),
+			"ibm_pi_instance_snapshots":                     power.DataSourceIBMPISnapshots(),
+			"ibm_pi_instance_volumes":                       power.DataSourceIBMPIInstanceVolumes(),
+			"ibm_pi_key":                                    power.DataSourceIBMPIKey(),
+			"ibm_pi_keys":                                   power.DataSourceIBMPIKeys(),
+			"ibm_pi_network":                                power.DataSourceIBMPINetwork(),
+			"ibm_pi_network_port":                           power.DataSourceIBMPINetworkPort(),
+			"ibm_pi_placement_group":                        power.DataSourceIBMPIPlacementGroup(),
+			"ibm_pi_placement_groups":                       power.DataSourceIBMPIPlacementGroups(),
+			"ibm_pi_public_network":                         power.DataSourceIBMPIPublicNetwork(),
+			"ibm_pi_pvm_snapshots":                          power.DataSourceIBMPISnapshot(),
+			"ibm_pi_sap_profile":                            power.DataSourceIBMPISAPProfile(),
+			"ibm_pi_sap_profiles":                           power.DataSourceIBMPISAPProfiles(),
+			"ibm_pi_storage_pool_capacity":                  power.DataSourceIBMPIStoragePoolCapacity(),
+			"ibm_pi_storage_pools_capacity":                 power.DataSourceIBMPIStoragePoolsCapacity(),
+			"ibm_pi_storage_type_capacity":                  power.DataSourceIBMPIStorageTypeCapacity(),
+			"ibm_pi_storage_types_capacity":                 power.DataSourceIBMPIStorageTypesCapacity(),
+			"ibm_pi_system_pools":                           power.DataSourceIBMPISystemPools(),
+			"ibm_pi_tenant":                                 power.DataSourceIBMPITenant(),
+			"ibm_pi_volume":                                 power.DataSourceIBMPIVolume(),
+			"ibm_pi_volume_group":                           power.DataSourceIBMPIVolumeGroup(),
+			"ibm_pi_volume_groups":                          power.DataSourceIBMPIVolumeGroups(),
+			"ibm_pi_volume_group_remote_copy_relationships": power.DataSourceIBMPIVolumeGroupRemoteCopyRelationships(),
+			"ibm_pi_volume_group_storage_details":           power.DataSourceIBMPIVolumeGroupStorageDetails(),
+			"ibm_pi_shared_processor_pool":                  power.DataSourceIBMPISharedProcessorPool(),
+			"ibm_pi_shared_processor_pools":                 power.DataSourceIBMPISharedProcessorPools(),
+			"ibm_pi_spp_placement_group":                    power.DataSourceIBMPISPPPlacementGroup(),
+			"ibm_pi_spp_placement_groups":                   power.DataSourceIBMPISPPPlacementGroups(),
 
 			// // Added for private dns zones
 
@@ -750,6 +760,9 @@ func Provider() *schema.Provider {
 			"ibm_function_namespace":                    functions.ResourceIBMFunctionNamespace(),
 			"ibm_cis":                                   cis.ResourceIBMCISInstance(),
 			"ibm_database":                              database.ResourceIBMDatabaseInstance(),
+			"ibm_database_user":                         database.ResourceIBMDatabaseUser(),
+			"ibm_database_allowlist_entry":              database.ResourceIBMDatabaseAllowlistEntry(),
+			"ibm_database_read_replica":                 database.ResourceIBMDatabaseReadReplica(),
 			"ibm_certificate_manager_import":            certificatemanager.ResourceIBMCertificateManagerImport(),
 			"ibm_certificate_manager_order":             certificatemanager.ResourceIBMCertificateManagerOrder(),
 			"ibm_cis_domain":                            cis.ResourceIBMCISDomain(),
@@ -821,13 +834,20 @@ func Provider() *schema.Provider {
 			"ibm_ob_monitoring":                         kubernetes.ResourceIBMObMonitoring(),
 			"ibm_cos_bucket":                            cos.ResourceIBMCOSBucket(),
 			"ibm_cos_bucket_replication_rule":           cos.ResourceIBMCOSBucketReplicationConfiguration(),
+			"ibm_cos_bucket_lifecycle_configuration":    cos.ResourceIBMCOSBucketLifecycleConfiguration(),
+			"ibm_cos_bucket_versioning":                 cos.ResourceIBMCOSBucketVersioning(),
+			"ibm_cos_bucket_monitoring":                 cos.ResourceIBMCOSBucketMonitoring(),
 			"ibm_cos_bucket_object":                     cos.ResourceIBMCOSBucketObject(),
+			"ibm_cos_bucket_sync":                       cos.ResourceIBMCOSBucketSync(),
 			"ibm_dns_domain":                            classicinfrastructure.ResourceIBMDNSDomain(),
 			"ibm_dns_domain_registration_nameservers":   classicinfrastructure.ResourceIBMDNSDomainRegistrationNameservers(),
 			"ibm_dns_secondary":                         classicinfrastructure.ResourceIBMDNSSecondary(),
 			"ibm_dns_record":                            classicinfrastructure.ResourceIBMDNSRecord(),
 			"ibm_event_streams_topic":                   eventstreams.ResourceIBMEventStreamsTopic(),
 			"ibm_event_streams_schema":                  eventstreams.ResourceIBMEventStreamsSchema(),
+			"ibm_event_streams_acl":                     eventstreams.ResourceIBMEventStreamsACL(),
+			"ibm_event_streams_quota":                   eventstreams.ResourceIBMEventStreamsQuota(),
+			"ibm_event_streams_mirroring_config":        eventstreams.ResourceIBMEventStreamsMirroringConfig(),
 			"ibm_firewall":                              classicinfrastructure.ResourceIBMFirewall(),
 			"ibm_firewall_policy":                       classicinfrastructure.ResourceIBMFirewallPolicy(),
 			"ibm_hpcs":                                  hpcs.ResourceIBMHPCS(),
@@ -839,12 +859,15 @@ func Provider() *schema.Provider {
 			"ibm_iam_account_settings":                  iamidentity.ResourceIBMIAMAccountSettings(),
 			"ibm_iam_custom_role":                       iampolicy.ResourceIBMIAMCustomRole(),
 			"ibm_iam_access_group_dynamic_rule":         iamaccessgroup.ResourceIBMIAMDynamicRule(),
+			"ibm_iam_access_group_member":               iamaccessgroup.ResourceIBMIAMAccessGroupMember(),
 			"ibm_iam_access_group_members":              iamaccessgroup.ResourceIBMIAMAccessGroupMembers(),
 			"ibm_iam_access_group_policy":               iampolicy.ResourceIBMIAMAccessGroupPolicy(),
+			"ibm_iam_access_group_policies":             iampolicy.ResourceIBMIAMAccessGroupPolicies(),
 			"ibm_iam_authorization_policy":              iampolicy.ResourceIBMIAMAuthorizationPolicy(),
 			"ibm_iam_authorization_policy_detach":       iampolicy.ResourceIBMIAMAuthorizationPolicyDetach(),
 			"ibm_iam_user_policy":                       iampolicy.ResourceIBMIAMUserPolicy(),
 			"ibm_iam_user_settings":                     iamidentity.ResourceIBMIAMUserSettings(),
+			"ibm_iam_user_mfa_enrollments":              iamidentity.ResourceIBMIAMUserMfaEnrollments(),
 			"ibm_iam_service_id":                        iamidentity.ResourceIBMIAMServiceID(),
 			"ibm_iam_service_api_key":                   iamidentity.ResourceIBMIAMServiceAPIKey(),
 			"ibm_iam_service_policy":                    iampolicy.ResourceIBMIAMServicePolicy(),
@@ -946,6 +969,9 @@ func Provider() *schema.Provider {
 			"ibm_kms_key_alias":                                  kms.ResourceIBMKmskeyAlias(),
 			"ibm_kms_key_rings":                                  kms.ResourceIBMKmskeyRings(),
 			"ibm_kms_key_policies":                               kms.ResourceIBMKmskeyPolicies(),
+			"ibm_kms_instance_policies":                          kms.ResourceIBMKmsInstancePolicies(),
+			"ibm_kms_kmip_adapter":                               kms.ResourceIBMKmsKmipAdapter(),
+			"ibm_kms_kmip_client_cert":                           kms.ResourceIBMKmsKmipClientCertificate(),
 			"ibm_kp_key":                                         kms.ResourceIBMkey(),
 			"ibm_resource_group":                                 resourcemanager.ResourceIBMResourceGroup(),
 			"ibm_resource_instance":                              resourcecontroller.ResourceIBMResourceInstance(),
@@ -986,6 +1012,11 @@ func Provider() *schema.Provider {
 			"ibm_pi_vpn_connection":                  power.ResourceIBMPIVPNConnection(),
 			"ibm_pi_console_language":                power.ResourceIBMPIInstanceConsoleLanguage(),
 			"ibm_pi_placement_group":                 power.ResourceIBMPIPlacementGroup(),
+			"ibm_pi_volume_group":                    power.ResourceIBMPIVolumeGroup(),
+			"ibm_pi_volume_group_action":             power.ResourceIBMPIVolumeGroupAction(),
+			"ibm_pi_workspace":                       power.ResourceIBMPIWorkspace(),
+			"ibm_pi_shared_processor_pool":           power.ResourceIBMPISharedProcessorPool(),
+			"ibm_pi_spp_placement_group":             power.ResourceIBMPISPPPlacementGroup(),
 
 			// //Private DNS related resources
 			"ibm_dns_zone":              dnsservices.ResourceIBMPrivateDNSZone(),
@@ -1029,6 +1060,15 @@ func Provider() *schema.Provider {
 			"ibm_schematics_inventory":      schematics.ResourceIBMSchematicsInventory(),
 			"ibm_schematics_resource_query": schematics.ResourceIBMSchematicsResourceQuery(),
 
+			// Secrets Manager
+			"ibm_secrets_manager_secret_group":             secretsmanager.ResourceIBMSecretsManagerSecretGroup(),
+			"ibm_secrets_manager_arbitrary_secret":         secretsmanager.ResourceIBMSecretsManagerArbitrarySecret(),
+			"ibm_secrets_manager_username_password_secret": secretsmanager.ResourceIBMSecretsManagerUsernamePasswordSecret(),
+			"ibm_secrets_manager_iam_credentials_secret":   secretsmanager.ResourceIBMSecretsManagerIamCredentialsSecret(),
+			"ibm_secrets_manager_kv_secret":                secretsmanager.ResourceIBMSecretsManagerKvSecret(),
+			"ibm_secrets_manager_imported_certificate":     secretsmanager.ResourceIBMSecretsManagerImportedCertificate(),
+			"ibm_secrets_manager_secret_rotation_policy":   secretsmanager.ResourceIBMSecretsManagerSecretRotationPolicy(),
+
 			// //satellite  resources
 			"ibm_satellite_location":                            satellite.ResourceIBMSatelliteLocation(),
 			"ibm_satellite_host":                                satellite.ResourceIBMSatelliteHost(),
@@ -1136,6 +1176,7 @@ func Validator() validate.ValidatorDict {
 		globalValidatorDict = validate.ValidatorDict{
 			ResourceValidatorDictionary: map[string]*validate.ResourceValidator{
 				"ibm_iam_account_settings":        iamidentity.ResourceIBMIAMAccountSettingsValidator(),
+				"ibm_iam_user_mfa_enrollments":    iamidentity.ResourceIBMIAMUserMfaEnrollmentsValidator(),
 				"ibm_iam_custom_role":             iampolicy.ResourceIBMIAMCustomRoleValidator(),
 				"ibm_cis_healthcheck":             cis.ResourceIBMCISHealthCheckValidator(),
 				"ibm_cis_rate_limit":              cis.ResourceIBMCISRateLimitValidator(),
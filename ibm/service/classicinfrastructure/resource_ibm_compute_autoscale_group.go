@@ -245,7 +245,7 @@ func getVirtualGuestTemplate(vGuestTemplateList []interface{}, meta interface{})
 	privateVlan := vGuestResourceData.Get("private_vlan_id").(int)
 	quote_id := 0
 	// Get the virtual guest creation template from the completed resource data object
-	vgs, err := getVirtualGuestTemplateFromResourceData(vGuestResourceData, meta, dc, publicVlan, privateVlan, quote_id)
+	vgs, err := getVirtualGuestTemplateFromResourceData(vGuestResourceData, meta, getVMMembers(vGuestResourceData), dc, publicVlan, privateVlan, quote_id)
 	return vgs[0], err
 }
 
@@ -5,6 +5,7 @@ package classicinfrastructure
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"errors"
@@ -12,6 +13,7 @@ import (
 	"log"
 	"math"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -19,8 +21,10 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
 	"github.com/softlayer/softlayer-go/helpers/product"
@@ -59,18 +63,23 @@ const (
 	virtualGuestAvailable    = "available"
 	virtualGuestProvisioning = "provisioning"
 
+	bulkVMFailurePolicyFail     = "fail"
+	bulkVMFailurePolicyRetry    = "retry"
+	bulkVMFailurePolicyRollback = "rollback"
+
 	networkStorageMassAccessControlModificationException = "SoftLayer_Exception_Network_Storage_Group_MassAccessControlModification"
 	retryDelayForModifyingStorageAccess                  = 10 * time.Second
 )
 
 func ResourceIBMComputeVmInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeVmInstanceCreate,
-		Read:     resourceIBMComputeVmInstanceRead,
-		Update:   resourceIBMComputeVmInstanceUpdate,
-		Delete:   resourceIBMComputeVmInstanceDelete,
-		Exists:   resourceIBMComputeVmInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeVmInstanceCreate,
+		ReadContext:   resourceIBMComputeVmInstanceRead,
+		UpdateContext: resourceIBMComputeVmInstanceUpdate,
+		DeleteContext: resourceIBMComputeVmInstanceDelete,
+		Exists:        resourceIBMComputeVmInstanceExists,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMComputeVmInstanceBulkModeCustomizeDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
@@ -105,7 +114,6 @@ func ResourceIBMComputeVmInstance() *schema.Resource {
 			"bulk_vms": {
 				Type:          schema.TypeSet,
 				Optional:      true,
-				ForceNew:      true,
 				MinItems:      2,
 				ConflictsWith: []string{"hostname", "domain"},
 				Elem: &schema.Resource{
@@ -113,7 +121,6 @@ func ResourceIBMComputeVmInstance() *schema.Resource {
 						"hostname": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 							DiffSuppressFunc: func(k, o, n string, d *schema.ResourceData) bool {
 								// FIXME: Work around another bug in terraform.
 								// When a default function is used with an optional property,
@@ -130,13 +137,34 @@ func ResourceIBMComputeVmInstance() *schema.Resource {
 						"domain": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
+						},
+
+						"guest_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the virtual guest ordered for this member",
 						},
 					},
 				},
 				Set: resourceIBMBulkVMHostHash,
 			},
 
+			"bulk_vms_failure_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      bulkVMFailurePolicyFail,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{bulkVMFailurePolicyFail, bulkVMFailurePolicyRetry, bulkVMFailurePolicyRollback}),
+				Description:  "What to do with bulk_vms members which fail to provision: fail, retry or rollback",
+			},
+
+			"bulk_vms_max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntBetween(1, 5),
+				Description:  "The number of times failed bulk_vms members are ordered again with the retry failure policy",
+			},
+
 			"os_reference_code": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -707,7 +735,7 @@ func expandSecurityGroupBindings(securityGroupsList []interface{}) ([]datatypes.
 	return sgBindings, nil
 }
 
-func getVirtualGuestTemplateFromResourceData(d *schema.ResourceData, meta interface{}, datacenter string, publicVlanID, privateVlanID, quote_id int) ([]datatypes.Virtual_Guest, error) {
+func getVirtualGuestTemplateFromResourceData(d *schema.ResourceData, meta interface{}, members []vmMember, datacenter string, publicVlanID, privateVlanID, quote_id int) ([]datatypes.Virtual_Guest, error) {
 
 	dc := datatypes.Location{
 		Name: sl.String(datacenter),
@@ -723,20 +751,6 @@ func getVirtualGuestTemplateFromResourceData(d *schema.ResourceData, meta interf
 	networkComponent := datatypes.Virtual_Guest_Network_Component{
 		MaxSpeed: &networkSpeed,
 	}
-	members := []vmMember{}
-	bulkVMs := d.Get("bulk_vms").(*schema.Set).List()
-	if len(bulkVMs) > 0 {
-		for _, vm := range bulkVMs {
-			members = append(members, vm.(map[string]interface{}))
-		}
-	} else {
-		member := vmMember{
-			"hostname": d.Get("hostname").(string),
-			"domain":   d.Get("domain").(string),
-		}
-		members = append(members, member)
-	}
-
 	vms := make([]datatypes.Virtual_Guest, 0)
 	for _, member := range members {
 		opts := datatypes.Virtual_Guest{
@@ -1033,38 +1047,217 @@ func getVirtualGuestTemplateFromResourceData(d *schema.ResourceData, meta interf
 	return vms, nil
 }
 
-func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-
-	sess := meta.(conns.ClientSession).SoftLayerSession()
-	service := services.GetVirtualGuestService(sess)
-
-	var id int
-	var receipt datatypes.Container_Product_Order_Receipt
-
-	var err1 error
-	var err error
-
+func resourceIBMComputeVmInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var dcName string
 	var retryOptions []interface{}
 	if dc, ok := d.GetOk("datacenter"); ok {
 		dcName = dc.(string)
 	}
 
-	quote_id := d.Get("quote_id").(int)
-
 	if options, ok := d.GetOk("datacenter_choice"); ok {
 		retryOptions = options.([]interface{})
 	}
 
 	if dcName == "" && len(retryOptions) == 0 {
-		return fmt.Errorf("[ERROR] Provide  either `datacenter` or `datacenter_choice`")
+		return diag.FromErr(fmt.Errorf("[ERROR] Provide  either `datacenter` or `datacenter_choice`"))
 	}
 
 	if (d.Get("hostname").(string) == "" || d.Get("domain").(string) == "") && len(d.Get("bulk_vms").(*schema.Set).List()) == 0 {
-		return fmt.Errorf("[ERROR] Provide  either `hostname` and `domain` or `bulk_vms`")
+		return diag.FromErr(fmt.Errorf("[ERROR] Provide  either `hostname` and `domain` or `bulk_vms`"))
+	}
+
+	members := getVMMembers(d)
+	guestIDs, err := createVMMembers(d, meta, members)
+
+	// Record only the guests that are ready. When some bulk_vms members failed the
+	// apply succeeds with a warning, so that the resource is not tainted and the
+	// next apply orders the missing members only.
+	setVMMemberIDs(d, members, guestIDs)
+	log.Printf("[INFO] Virtual Machine ID: %s", d.Id())
+	var diags diag.Diagnostics
+	if err != nil {
+		var partial *bulkVMPartialFailure
+		if !errors.As(err, &partial) || len(guestIDs) == 0 {
+			return diag.FromErr(err)
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Some bulk_vms members failed to provision",
+			Detail:   fmt.Sprintf("%s. The failed guests were cancelled and are ordered again on the next apply.", partial),
+		})
+	}
+
+	return append(diags, resourceIBMComputeVmInstanceRead(ctx, d, meta)...)
+}
+
+// bulkVMPartialFailure reports the bulk_vms members that failed to provision while
+// the other members are ready
+type bulkVMPartialFailure struct {
+	failed map[string]error
+}
+
+func (e *bulkVMPartialFailure) Error() string {
+	return fmt.Sprintf("[ERROR] Virtual guests failed to provision: %s", formatVMMemberErrors(e.failed))
+}
+
+// getVMMembers returns the hostname and domain of the guests managed by the resource
+func getVMMembers(d *schema.ResourceData) []vmMember {
+	members := []vmMember{}
+	bulkVMs := d.Get("bulk_vms").(*schema.Set).List()
+	if len(bulkVMs) > 0 {
+		for _, vm := range bulkVMs {
+			members = append(members, vm.(map[string]interface{}))
+		}
+	} else {
+		member := vmMember{
+			"hostname": d.Get("hostname").(string),
+			"domain":   d.Get("domain").(string),
+		}
+		members = append(members, member)
+	}
+	return members
+}
+
+// setVMMemberIDs sets the resource ID to the guest IDs of the members, in
+// member order, and records the guest ID of each bulk_vms member
+func setVMMemberIDs(d *schema.ResourceData, members []vmMember, guestIDs map[string]int) {
+	idStrings := make([]string, 0, len(guestIDs))
+	bulkVMs := make([]map[string]interface{}, 0, len(guestIDs))
+	for _, member := range members {
+		hostname := member["hostname"].(string)
+		id, ok := guestIDs[hostname]
+		if !ok {
+			continue
+		}
+		idStrings = append(idStrings, strconv.Itoa(id))
+		bulkVMs = append(bulkVMs, map[string]interface{}{
+			"hostname": hostname,
+			"domain":   member["domain"].(string),
+			"guest_id": id,
+		})
 	}
+	d.SetId(strings.Join(idStrings, "/"))
+	if len(d.Get("bulk_vms").(*schema.Set).List()) > 0 {
+		d.Set("bulk_vms", bulkVMs)
+	}
+}
+
+// createVMMembers orders a virtual guest for each member and waits for them to be
+// ready. Members which fail to provision are handled according to
+// bulk_vms_failure_policy. The returned map holds the guest ID, by hostname, of
+// every member that is still ordered. When only some bulk_vms members fail, their
+// guests are cancelled and a *bulkVMPartialFailure is returned.
+func createVMMembers(d *schema.ResourceData, meta interface{}, members []vmMember) (map[string]int, error) {
+	policy := bulkVMFailurePolicyFail
+	isBulk := len(d.Get("bulk_vms").(*schema.Set).List()) > 0
+	if isBulk {
+		policy = d.Get("bulk_vms_failure_policy").(string)
+	}
+	retries := 0
+	if policy == bulkVMFailurePolicyRetry {
+		retries = d.Get("bulk_vms_max_retries").(int)
+	}
+
+	guestIDs := map[string]int{}
+	pending := members
+	for attempt := 0; ; attempt++ {
+		orderedIDs, err := orderVMMembers(d, meta, pending)
+		if err != nil {
+			if len(guestIDs) > 0 {
+				// a retry order failed, the members of earlier orders are ready
+				failed := map[string]error{}
+				for _, member := range pending {
+					failed[member["hostname"].(string)] = fmt.Errorf("Error ordering virtual guest: %s", err)
+				}
+				return guestIDs, &bulkVMPartialFailure{failed: failed}
+			}
+			return guestIDs, fmt.Errorf("[ERROR] Error ordering virtual guest: %s", err)
+		}
+		for hostname, id := range orderedIDs {
+			guestIDs[hostname] = id
+		}
+
+		failed := map[string]error{}
+		for _, member := range pending {
+			hostname := member["hostname"].(string)
+			if err := configureVirtualGuest(guestIDs[hostname], d, meta); err != nil {
+				log.Printf("[WARN] Virtual guest %s (%d) failed to provision: %s", hostname, guestIDs[hostname], err)
+				failed[hostname] = err
+			}
+		}
+		if len(failed) == 0 {
+			return guestIDs, nil
+		}
 
-	if dcName != "" {
+		switch {
+		case policy == bulkVMFailurePolicyRollback:
+			for hostname, id := range guestIDs {
+				if err := deleteVirtualGuest(id, d, meta); err != nil {
+					log.Printf("[WARN] Rolling back virtual guest %s (%d) failed: %s", hostname, id, err)
+					continue
+				}
+				delete(guestIDs, hostname)
+			}
+			return guestIDs, fmt.Errorf("[ERROR] Rolled back the order, virtual guests failed to provision: %s", formatVMMemberErrors(failed))
+
+		case policy == bulkVMFailurePolicyRetry && attempt < retries:
+			retry := make([]vmMember, 0, len(failed))
+			for _, member := range pending {
+				hostname := member["hostname"].(string)
+				if _, ok := failed[hostname]; !ok {
+					continue
+				}
+				if err := deleteVirtualGuest(guestIDs[hostname], d, meta); err != nil {
+					return guestIDs, fmt.Errorf("[ERROR] Error cancelling failed virtual guest %s before retry: %s", hostname, err)
+				}
+				delete(guestIDs, hostname)
+				retry = append(retry, member)
+			}
+			log.Printf("[INFO] Retrying %d failed virtual guests (attempt %d of %d)", len(retry), attempt+1, retries)
+			pending = retry
+
+		default:
+			if !isBulk {
+				for _, err := range failed {
+					return guestIDs, err
+				}
+			}
+			// Cancel the failed guests so that only the ready members are recorded
+			for hostname := range failed {
+				id := guestIDs[hostname]
+				if err := deleteVirtualGuest(id, d, meta); err != nil {
+					failed[hostname] = fmt.Errorf("%s; cancelling virtual guest %d failed: %s", failed[hostname], id, err)
+				}
+				delete(guestIDs, hostname)
+			}
+			return guestIDs, &bulkVMPartialFailure{failed: failed}
+		}
+	}
+}
+
+func formatVMMemberErrors(failed map[string]error) string {
+	hostnames := make([]string, 0, len(failed))
+	for hostname := range failed {
+		hostnames = append(hostnames, hostname)
+	}
+	sort.Strings(hostnames)
+	msgs := make([]string, 0, len(hostnames))
+	for _, hostname := range hostnames {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", hostname, failed[hostname]))
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// orderVMMembers places one order for the members in the configured datacenter or
+// in the first datacenter_choice that accepts it, and returns the ordered guest
+// IDs by hostname
+func orderVMMembers(d *schema.ResourceData, meta interface{}, members []vmMember) (map[string]int, error) {
+	var receipt datatypes.Container_Product_Order_Receipt
+	var err1 error
+
+	quote_id := d.Get("quote_id").(int)
+
+	if dc, ok := d.GetOk("datacenter"); ok {
 		publicVlan := 0
 		privateVlan := 0
 		if v, ok := d.GetOk("public_vlan_id"); ok {
@@ -1076,16 +1269,16 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 
 		}
 
-		receipt, err1 = placeOrder(d, meta, dcName, publicVlan, privateVlan, quote_id)
-	} else if len(retryOptions) > 0 {
-
+		receipt, err1 = placeOrder(d, meta, members, dc.(string), publicVlan, privateVlan, quote_id)
+	} else if options, ok := d.GetOk("datacenter_choice"); ok {
+		retryOptions := options.([]interface{})
 		err := validate.ValidateDatacenterOption(retryOptions, []string{"datacenter", "public_vlan_id", "private_vlan_id"})
 		if err != nil {
-			return err
+			return nil, err
 		}
 		for _, option := range retryOptions {
 			if option == nil {
-				return fmt.Errorf("[ERROR] Provide  a valid `datacenter_choice`")
+				return nil, fmt.Errorf("[ERROR] Provide  a valid `datacenter_choice`")
 			}
 			center := option.(map[string]interface{})
 			var publicVlan, privateVlan int
@@ -1094,7 +1287,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 			if v, ok := center["datacenter"]; ok {
 				name = v.(string)
 			} else {
-				return fmt.Errorf("Missing datacenter in `datacenter_choice`")
+				return nil, fmt.Errorf("Missing datacenter in `datacenter_choice`")
 			}
 
 			if v, ok := center["public_vlan_id"]; ok {
@@ -1104,7 +1297,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 				privateVlan, _ = strconv.Atoi(v.(string))
 			}
 
-			receipt, err1 = placeOrder(d, meta, name, publicVlan, privateVlan, quote_id)
+			receipt, err1 = placeOrder(d, meta, members, name, publicVlan, privateVlan, quote_id)
 			if err1 == nil {
 				break
 
@@ -1113,84 +1306,140 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	if err1 != nil {
-		return fmt.Errorf("[ERROR] Error ordering virtual guest: %s", err1)
+		return nil, err1
 	}
 
-	var idStrings []string
+	guestIDs := map[string]int{}
 	if quote_id > 0 {
-		vmId := fmt.Sprintf("%d", *receipt.OrderDetails.VirtualGuests[0].Id)
-		idStrings = append(idStrings, vmId)
-		d.SetId(vmId)
-
-	} else if len(receipt.OrderDetails.OrderContainers) > 1 {
-		for i := 0; i < len(receipt.OrderDetails.OrderContainers); i++ {
-			idStrings = append(idStrings, fmt.Sprintf("%d", *receipt.OrderDetails.OrderContainers[i].VirtualGuests[0].Id))
-		}
-		d.SetId(strings.Join(idStrings, "/"))
-	} else {
-		vmId := fmt.Sprintf("%d", *receipt.OrderDetails.OrderContainers[0].VirtualGuests[0].Id)
-		idStrings = append(idStrings, vmId)
-		d.SetId(vmId)
+		// The hostname of a quote order comes from the quote
+		guestIDs[members[0]["hostname"].(string)] = *receipt.OrderDetails.VirtualGuests[0].Id
+		return guestIDs, nil
 	}
-	log.Printf("[INFO] Virtual Machine ID: %s", d.Id())
-	for _, str := range idStrings {
-		id, err = strconv.Atoi(str)
-		if err != nil {
-			return err
-		}
-		// Set tags
-		tags := getTags(d)
-		if tags != "" {
-			//Try setting only when it is non empty as we are creating virtual guest
-			err = setGuestTags(id, tags, meta)
-			if err != nil {
-				return err
-			}
-		}
 
-		var storageIds []int
-		if fileStorageSet := d.Get("file_storage_ids").(*schema.Set); len(fileStorageSet.List()) > 0 {
-			storageIds = flex.ExpandIntList(fileStorageSet.List())
-
-		}
-		if blockStorageSet := d.Get("block_storage_ids").(*schema.Set); len(blockStorageSet.List()) > 0 {
-			storageIds = append(storageIds, flex.ExpandIntList(blockStorageSet.List())...)
-		}
-		if len(storageIds) > 0 {
-			err := addAccessToStorageList(service.Id(id), id, storageIds, meta)
-			if err != nil {
-				return err
+	// The order containers are matched to the members by hostname, the receipt
+	// does not guarantee the order of the request
+	hostnames := map[string]string{}
+	for _, member := range members {
+		hostname := member["hostname"].(string)
+		hostnames[strings.ToLower(hostname)] = hostname
+	}
+	ordered := []string{}
+	matched := true
+	for _, container := range receipt.OrderDetails.OrderContainers {
+		for _, guest := range container.VirtualGuests {
+			if guest.Id == nil {
+				continue
+			}
+			ordered = append(ordered, strconv.Itoa(*guest.Id))
+			hostname, ok := "", false
+			if guest.Hostname != nil {
+				hostname, ok = hostnames[strings.ToLower(*guest.Hostname)]
+			}
+			if !ok {
+				matched = false
+				continue
 			}
+			guestIDs[hostname] = *guest.Id
 		}
+	}
+	if !matched || len(guestIDs) != len(members) {
+		return nil, fmt.Errorf("[ERROR] The virtual guests %s of the order do not match the bulk_vms hostnames, cancel them before applying again", strings.Join(ordered, ", "))
+	}
+	return guestIDs, nil
+}
+
+// configureVirtualGuest sets the tags, storage access and notes of a newly
+// ordered guest and waits for it to become available
+func configureVirtualGuest(id int, d *schema.ResourceData, meta interface{}) error {
+	service := services.GetVirtualGuestService(meta.(conns.ClientSession).SoftLayerSession())
 
-		// Set notes
-		err = setNotes(id, d, meta)
+	// Set tags
+	tags := getTags(d)
+	if tags != "" {
+		//Try setting only when it is non empty as we are creating virtual guest
+		err := setGuestTags(id, tags, meta)
 		if err != nil {
 			return err
 		}
+	}
 
-		// wait for machine availability
-
-		_, err = WaitForVirtualGuestAvailable(id, d, meta)
+	var storageIds []int
+	if fileStorageSet := d.Get("file_storage_ids").(*schema.Set); len(fileStorageSet.List()) > 0 {
+		storageIds = flex.ExpandIntList(fileStorageSet.List())
 
+	}
+	if blockStorageSet := d.Get("block_storage_ids").(*schema.Set); len(blockStorageSet.List()) > 0 {
+		storageIds = append(storageIds, flex.ExpandIntList(blockStorageSet.List())...)
+	}
+	if len(storageIds) > 0 {
+		err := addAccessToStorageList(service.Id(id), id, storageIds, meta)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for virtual machine (%s) to become ready: %s", d.Id(), err)
+			return err
 		}
 	}
 
-	return resourceIBMComputeVmInstanceRead(d, meta)
+	// Set notes
+	err := setNotes(id, d, meta)
+	if err != nil {
+		return err
+	}
+
+	// wait for machine availability
+	_, err = WaitForVirtualGuestAvailable(id, d, meta)
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for virtual machine (%d) to become ready: %s", id, err)
+	}
+	return nil
 }
 
-func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeVmInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	service := services.GetVirtualGuestService(meta.(conns.ClientSession).SoftLayerSession())
 	parts, err := flex.VmIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
+
+	isBulk := len(parts) > 1 || len(d.Get("bulk_vms").(*schema.Set).List()) > 0
+	if isBulk {
+		// Track each member individually; members whose guest is gone are dropped
+		// so that the next plan orders them again
+		bulkVMs := make([]map[string]interface{}, 0, len(parts))
+		idStrings := make([]string, 0, len(parts))
+		for _, part := range parts {
+			vmId, err := strconv.Atoi(part)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
+			}
+			vmResult, err := service.Id(vmId).Mask(
+				"id,hostname,domain",
+			).GetObject()
+			if err != nil {
+				if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+					log.Printf("[WARN] Virtual guest %d of bulk_vms no longer exists", vmId)
+					continue
+				}
+				return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving virtual guest %d: %s", vmId, err))
+			}
+			bulkVMs = append(bulkVMs, map[string]interface{}{
+				"hostname": *vmResult.Hostname,
+				"domain":   *vmResult.Domain,
+				"guest_id": vmId,
+			})
+			idStrings = append(idStrings, part)
+		}
+		if len(idStrings) == 0 {
+			d.SetId("")
+			return nil
+		}
+		d.SetId(strings.Join(idStrings, "/"))
+		d.Set("bulk_vms", bulkVMs)
+		parts = idStrings
+	}
+
 	id, err := strconv.Atoi(parts[0])
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	result, err := service.Id(id).Mask(
@@ -1215,28 +1464,12 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 	).GetObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving virtual guest: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving virtual guest: %s", err))
 	}
 
-	if len(parts) == 1 {
+	if !isBulk {
 		d.Set("hostname", *result.Hostname)
 		d.Set("domain", *result.Domain)
-	} else {
-		members := make([]vmMember, 0)
-		for _, part := range parts {
-			vmId, err := strconv.Atoi(part)
-			if err != nil {
-				return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
-			}
-			vmResult, err := service.Id(vmId).Mask(
-				"hostname,domain",
-			).GetObject()
-			member := vmMember{
-				"hostname": *vmResult.Hostname,
-				"domain":   *vmResult.Domain,
-			}
-			members = append(members, member)
-		}
 	}
 
 	keyName, ok := sl.GrabOk(result, "BillingItem.OrderItem.Preset.KeyName")
@@ -1422,7 +1655,7 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 	d.Set(flex.ResourceName, *result.Hostname)
 	d.Set(flex.ResourceStatus, *result.Status.Name)
 	err = readSecondaryIPAddresses(d, meta, result.PrimaryIpAddress)
-	return err
+	return diag.FromErr(err)
 }
 
 func readSecondaryIPAddresses(d *schema.ResourceData, meta interface{}, primaryIPAddress *string) error {
@@ -1452,20 +1685,127 @@ func readSecondaryIPAddresses(d *schema.ResourceData, meta interface{}, primaryI
 	}
 	return nil
 }
-func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-
-	sess := meta.(conns.ClientSession).SoftLayerSession()
-	service := services.GetVirtualGuestService(sess)
+func resourceIBMComputeVmInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Scale bulk_vms up and down without recreating the unchanged members. Switching
+	// between a single guest and bulk_vms replaces the resource, see the CustomizeDiff.
+	created := map[int]bool{}
+	if o, n := d.GetChange("bulk_vms"); o.(*schema.Set).Len() > 0 && n.(*schema.Set).Len() > 0 && d.HasChange("bulk_vms") {
+		var err error
+		created, err = updateBulkVMMembers(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	parts, err := flex.VmIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	id, err := strconv.Atoi(parts[0])
-	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+	for _, part := range parts {
+		id, err := strconv.Atoi(part)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
+		}
+		// Newly ordered members already have the current configuration
+		if created[id] {
+			continue
+		}
+		err = updateVirtualGuest(id, d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
+	return resourceIBMComputeVmInstanceRead(ctx, d, meta)
+}
+
+// resourceIBMComputeVmInstanceBulkModeCustomizeDiff replaces the resource when it switches
+// between a single guest and bulk_vms, members are only added and removed in place when
+// both the old and the new configuration use bulk_vms
+func resourceIBMComputeVmInstanceBulkModeCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("bulk_vms") {
+		return nil
+	}
+	o, n := diff.GetChange("bulk_vms")
+	if (o.(*schema.Set).Len() > 0) != (n.(*schema.Set).Len() > 0) {
+		return diff.ForceNew("bulk_vms")
+	}
+	return nil
+}
+
+// updateBulkVMMembers cancels the guests of removed bulk_vms members, updates the
+// domain of changed members and orders guests for added members. It returns the
+// IDs of the newly ordered guests.
+func updateBulkVMMembers(d *schema.ResourceData, meta interface{}) (map[int]bool, error) {
+	service := services.GetVirtualGuestService(meta.(conns.ClientSession).SoftLayerSession())
+
+	oldRaw, _ := d.GetChange("bulk_vms")
+	oldMembers := map[string]map[string]interface{}{}
+	for _, m := range oldRaw.(*schema.Set).List() {
+		member := m.(map[string]interface{})
+		oldMembers[member["hostname"].(string)] = member
+	}
+
+	members := getVMMembers(d)
+	guestIDs := map[string]int{}
+	added := []vmMember{}
+	for _, member := range members {
+		hostname := member["hostname"].(string)
+		old, ok := oldMembers[hostname]
+		if !ok || old["guest_id"].(int) == 0 {
+			added = append(added, member)
+			continue
+		}
+		id := old["guest_id"].(int)
+		guestIDs[hostname] = id
+		delete(oldMembers, hostname)
+
+		if domain := member["domain"].(string); domain != old["domain"].(string) {
+			guest, err := service.Id(id).GetObject()
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error retrieving virtual guest: %s", err)
+			}
+			guest.Domain = sl.String(domain)
+			_, err = service.Id(id).EditObject(&guest)
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Could n't update virtual guest: %s", err)
+			}
+		}
+	}
+
+	// What is left are the members removed from bulk_vms
+	for hostname, old := range oldMembers {
+		id := old["guest_id"].(int)
+		if id == 0 {
+			continue
+		}
+		log.Printf("[INFO] Removing virtual guest %s (%d) from bulk_vms", hostname, id)
+		err := deleteVirtualGuest(id, d, meta)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	created := map[int]bool{}
+	var err error
+	if len(added) > 0 {
+		var addedIDs map[string]int
+		addedIDs, err = createVMMembers(d, meta, added)
+		for hostname, id := range addedIDs {
+			guestIDs[hostname] = id
+			created[id] = true
+		}
+	}
+	setVMMemberIDs(d, members, guestIDs)
+
+	return created, err
+}
+
+// updateVirtualGuest applies the changed arguments to a single guest of the resource
+func updateVirtualGuest(id int, d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(conns.ClientSession).SoftLayerSession()
+	service := services.GetVirtualGuestService(sess)
+
 	result, err := service.Id(id).GetObject()
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error retrieving virtual guest: %s", err)
@@ -1579,7 +1919,7 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 		}
 
 		// Wait for softlayer to start upgrading...
-		_, err = WaitForUpgradeTransactionsToAppear(d, meta)
+		_, err = WaitForUpgradeTransactionsToAppear(id, d, meta)
 		if err != nil {
 			return err
 		}
@@ -1591,7 +1931,7 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 
 	}
 
-	return resourceIBMComputeVmInstanceRead(d, meta)
+	return nil
 }
 
 func modifyStorageAccess(sam storageAccessModifier, deviceID int, meta interface{}, d *schema.ResourceData) error {
@@ -1628,39 +1968,52 @@ func modifyStorageAccess(sam storageAccessModifier, deviceID int, meta interface
 	return nil
 }
 
-func resourceIBMComputeVmInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	sess := meta.(conns.ClientSession).SoftLayerSession()
-	service := services.GetVirtualGuestService(sess)
+func resourceIBMComputeVmInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.VmIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, part := range parts {
 		id, err := strconv.Atoi(part)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 		}
 
-		_, err = WaitForNoActiveTransactions(id, d, d.Timeout(schema.TimeoutDelete), meta)
-
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error deleting virtual guest, couldn't wait for zero active transactions: %s", err)
-		}
-		err = detachSecurityGroupNetworkComponentBindings(d, meta, id)
-		if err != nil {
-			return err
-		}
-		ok, err := service.Id(id).DeleteObject()
+		err = deleteVirtualGuest(id, d, meta)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error deleting virtual guest: %s", err)
+			return diag.FromErr(err)
 		}
+	}
 
-		if !ok {
-			return fmt.Errorf(
-				"API reported it was unsuccessful in removing the virtual guest '%d'", id)
+	return nil
+}
+
+// deleteVirtualGuest cancels a single guest of the resource; a guest that no
+// longer exists is not an error
+func deleteVirtualGuest(id int, d *schema.ResourceData, meta interface{}) error {
+	service := services.GetVirtualGuestService(meta.(conns.ClientSession).SoftLayerSession())
+
+	_, err := WaitForNoActiveTransactions(id, d, d.Timeout(schema.TimeoutDelete), meta)
+
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error deleting virtual guest, couldn't wait for zero active transactions: %s", err)
+	}
+	err = detachSecurityGroupNetworkComponentBindings(d, meta, id)
+	if err != nil {
+		return err
+	}
+	ok, err := service.Id(id).DeleteObject()
+	if err != nil {
+		if apiErr, isAPIErr := err.(sl.Error); isAPIErr && apiErr.StatusCode == 404 {
+			return nil
 		}
+		return fmt.Errorf("[ERROR] Error deleting virtual guest: %s", err)
 	}
 
+	if !ok {
+		return fmt.Errorf(
+			"API reported it was unsuccessful in removing the virtual guest '%d'", id)
+	}
 	return nil
 }
 
@@ -1730,17 +2083,8 @@ func genID() (interface{}, error) {
 }
 
 // WaitForUpgradeTransactionsToAppear Wait for upgrade transactions
-func WaitForUpgradeTransactionsToAppear(d *schema.ResourceData, meta interface{}) (interface{}, error) {
-	log.Printf("Waiting for server (%s) to have upgrade transactions", d.Id())
-
-	parts, err := flex.VmIdParts(d.Id())
-	if err != nil {
-		return nil, err
-	}
-	id, err := strconv.Atoi(parts[0])
-	if err != nil {
-		return nil, fmt.Errorf("[ERROR] The instance ID %s must be numeric", d.Id())
-	}
+func WaitForUpgradeTransactionsToAppear(id int, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	log.Printf("Waiting for server (%d) to have upgrade transactions", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"retry", pendingUpgrade},
@@ -1867,22 +2211,29 @@ func resourceIBMComputeVmInstanceExists(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return false, err
 	}
-	guestID, err := strconv.Atoi(parts[0])
-	if err != nil {
-		return false, fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
-	}
+	// The resource exists as long as one of its guests exists
+	for _, part := range parts {
+		guestID, err := strconv.Atoi(part)
+		if err != nil {
+			return false, fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		}
 
-	result, err := service.Id(guestID).GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
+		result, err := service.Id(guestID).GetObject()
+		if err != nil {
+			if apiErr, ok := err.(sl.Error); ok {
+				if apiErr.StatusCode == 404 {
+					continue
+				}
 			}
+			return false, fmt.Errorf("[ERROR] Error getting compute vm instance: %s", err)
+		}
+
+		if result.Id != nil && *result.Id == guestID {
+			return true, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting compute vm instance: %s", err)
 	}
 
-	return result.Id != nil && *result.Id == guestID, nil
+	return false, nil
 }
 
 func getTags(d dataRetriever) string {
@@ -1977,11 +2328,11 @@ func setNotes(id int, d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func placeOrder(d *schema.ResourceData, meta interface{}, name string, publicVlanID, privateVlanID, quote_id int) (datatypes.Container_Product_Order_Receipt, error) {
+func placeOrder(d *schema.ResourceData, meta interface{}, members []vmMember, name string, publicVlanID, privateVlanID, quote_id int) (datatypes.Container_Product_Order_Receipt, error) {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetVirtualGuestService(sess)
 
-	options, err := getVirtualGuestTemplateFromResourceData(d, meta, name, publicVlanID, privateVlanID, quote_id)
+	options, err := getVirtualGuestTemplateFromResourceData(d, meta, members, name, publicVlanID, privateVlanID, quote_id)
 	if err != nil {
 		return datatypes.Container_Product_Order_Receipt{}, err
 	}
@@ -218,6 +218,54 @@ func TestAccIBMComputeVMInstance_bulkvms(t *testing.T) {
 	})
 }
 
+func TestAccIBMComputeVMInstance_bulkvmsScale(t *testing.T) {
+	var guest datatypes.Virtual_Guest
+
+	hostname1 := acctest.RandString(16)
+	hostname2 := acctest.RandString(16)
+	hostname3 := acctest.RandString(16)
+	domain := "terraformvmuat.ibm.com"
+
+	configInstance := "ibm_compute_vm_instance.terraform-acceptance-test-bulk"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccIBMComputeVMInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMComputeVMInstanceConfigBulkVMsScale(domain, hostname1, hostname2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIBMComputeVMInstanceExists(configInstance, &guest),
+					resource.TestCheckResourceAttr(
+						configInstance, "bulk_vms.#", "2"),
+					resource.TestCheckResourceAttr(
+						configInstance, "bulk_vms_failure_policy", "retry"),
+				),
+			},
+			{
+				Config: testAccIBMComputeVMInstanceConfigBulkVMsScale(domain, hostname1, hostname2, hostname3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIBMComputeVMInstanceExists(configInstance, &guest),
+					resource.TestCheckResourceAttr(
+						configInstance, "bulk_vms.#", "3"),
+					resource.TestMatchResourceAttr(
+						configInstance, "id", regexp.MustCompile(`^\d+/\d+/\d+$`)),
+				),
+			},
+			{
+				Config: testAccIBMComputeVMInstanceConfigBulkVMsScale(domain, hostname1, hostname3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIBMComputeVMInstanceExists(configInstance, &guest),
+					resource.TestCheckResourceAttr(
+						configInstance, "bulk_vms.#", "2"),
+					resource.TestMatchResourceAttr(
+						configInstance, "id", regexp.MustCompile(`^\d+/\d+$`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMComputeVMInstanceWithFlavor(t *testing.T) {
 	var guest datatypes.Virtual_Guest
 
@@ -1038,6 +1086,32 @@ resource "ibm_compute_vm_instance" "terraform-acceptance-test-1" {
 }`, hostname, domain, networkSpeed, cores, memory, userMetadata, tags)
 }
 
+func testAccIBMComputeVMInstanceConfigBulkVMsScale(domain string, hostnames ...string) string {
+	var members strings.Builder
+	for _, hostname := range hostnames {
+		members.WriteString(fmt.Sprintf(`
+    bulk_vms {
+        hostname = "%s"
+        domain   = "%s"
+    }`, hostname, domain))
+	}
+	return fmt.Sprintf(`
+resource "ibm_compute_vm_instance" "terraform-acceptance-test-bulk" {
+    %s
+
+    os_reference_code       = "DEBIAN_9_64"
+    datacenter              = "wdc04"
+    network_speed           = 10
+    hourly_billing          = true
+    private_network_only    = false
+    cores                   = 1
+    memory                  = 1024
+    local_disk              = false
+    bulk_vms_failure_policy = "retry"
+    bulk_vms_max_retries    = 1
+}`, members.String())
+}
+
 func testAccIBMComputeVMInstanceConfigBulkVMs(hostname1, hostname2, domain, networkSpeed, cores, memory, userMetadata, tags string) string {
 	return fmt.Sprintf(`
 resource "ibm_compute_vm_instance" "terraform-acceptance-test-1" {
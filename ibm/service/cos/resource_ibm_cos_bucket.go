@@ -20,6 +20,7 @@ import (
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -66,8 +67,13 @@ func ResourceIBMCOSBucket() *schema.Resource {
 		Update:        resourceIBMCOSBucketUpdate,
 		Delete:        resourceIBMCOSBucketDelete,
 		Exists:        resourceIBMCOSBucketExists,
-		Importer:      &schema.ResourceImporter{},
-		CustomizeDiff: resourceExpiryValidate,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMCOSBucketImport,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			resourceExpiryValidate,
+			resourceObjectLockValidate,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -427,6 +433,218 @@ func ResourceIBMCOSBucket() *schema.Resource {
 					},
 				},
 			},
+			"website_configuration": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Host a static website from the bucket. Serve an index document and an error document, or redirect requests to another host.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_document": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"website_configuration.0.redirect_all_requests_to"},
+							Description:   "Suffix appended to requests for a directory, such as index.html",
+						},
+						"error_document": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"website_configuration.0.redirect_all_requests_to"},
+							Description:   "Object key returned when a 4XX error occurs",
+						},
+						"redirect_all_requests_to": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Redirect every request to the website endpoint of the bucket to another host",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host_name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Host name requests are redirected to",
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{s3.ProtocolHttp, s3.ProtocolHttps}),
+										Description:  "Protocol used in the redirect: http or https. Defaults to the protocol of the original request.",
+									},
+								},
+							},
+						},
+						"routing_rule": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							ConflictsWith: []string{"website_configuration.0.redirect_all_requests_to"},
+							Description:   "Rules that redirect requests matching a condition",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										MaxItems:    1,
+										Description: "Condition a request must match for the redirect to apply",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"http_error_code_returned_equals": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "HTTP error code that triggers the redirect, such as 404",
+												},
+												"key_prefix_equals": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "Object key prefix that triggers the redirect",
+												},
+											},
+										},
+									},
+									"redirect": {
+										Type:        schema.TypeList,
+										Required:    true,
+										MaxItems:    1,
+										Description: "Where a matching request is redirected",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"host_name": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "Host name used in the redirect",
+												},
+												"http_redirect_code": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "HTTP redirect code, such as 301",
+												},
+												"protocol": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validate.ValidateAllowedStringValues([]string{s3.ProtocolHttp, s3.ProtocolHttps}),
+													Description:  "Protocol used in the redirect: http or https",
+												},
+												"replace_key_prefix_with": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "Prefix that replaces key_prefix_equals in the redirect",
+												},
+												"replace_key_with": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "Object key used in the redirect",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"cors_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    100,
+				Description: "Cross-origin resource sharing rules of the bucket",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Headers allowed in a preflight request",
+						},
+						"allowed_methods": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.ValidateAllowedStringValues([]string{"GET", "PUT", "POST", "DELETE", "HEAD"}),
+							},
+							Description: "HTTP methods allowed from the origins: GET, PUT, POST, DELETE, HEAD",
+						},
+						"allowed_origins": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Origins allowed to access the bucket",
+						},
+						"expose_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Response headers the browser is allowed to access",
+						},
+						"max_age_seconds": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Time in seconds the browser caches a preflight response",
+						},
+					},
+				},
+			},
+			"public_access": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Allow anonymous read access to the objects in the bucket",
+			},
+			"object_lock_configuration": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"retention_rule"},
+				Description:   "Object Lock configuration of the bucket. Object Lock stores objects in a write-once-read-many (WORM) model and requires object versioning to be enabled. It cannot be disabled once enabled.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_lock_enabled": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{s3.ObjectLockEnabledEnabled}),
+							Description:  "Enable Object Lock on the bucket: Enabled",
+						},
+						"object_lock_rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Object Lock rule applied to new objects in the bucket",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default_retention": {
+										Type:        schema.TypeList,
+										Required:    true,
+										MaxItems:    1,
+										Description: "Default retention applied to new objects that do not specify one",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"mode": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validate.ValidateAllowedStringValues([]string{s3.ObjectLockRetentionModeCompliance}),
+													Description:  "Retention mode: COMPLIANCE",
+												},
+												"days": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validate.ValidateAllowedRangeInt(1, 36500),
+													Description:  "Default retention period in days",
+												},
+												"years": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validate.ValidateAllowedRangeInt(1, 100),
+													Description:  "Default retention period in years",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"hard_quota": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -660,6 +878,107 @@ func expireRuleList(expireList []interface{}) []*s3.LifecycleRule {
 	return rules
 }
 
+func websiteConfiguration(websiteList []interface{}) *s3.WebsiteConfiguration {
+	websiteConf := &s3.WebsiteConfiguration{}
+	for _, l := range websiteList {
+		websiteMap, _ := l.(map[string]interface{})
+		if index, ok := websiteMap["index_document"].(string); ok && index != "" {
+			websiteConf.IndexDocument = &s3.IndexDocument{Suffix: aws.String(index)}
+		}
+		if errorDoc, ok := websiteMap["error_document"].(string); ok && errorDoc != "" {
+			websiteConf.ErrorDocument = &s3.ErrorDocument{Key: aws.String(errorDoc)}
+		}
+		if redirectList, ok := websiteMap["redirect_all_requests_to"].([]interface{}); ok && len(redirectList) > 0 && redirectList[0] != nil {
+			redirectMap := redirectList[0].(map[string]interface{})
+			redirectAll := &s3.RedirectAllRequestsTo{HostName: aws.String(redirectMap["host_name"].(string))}
+			if protocol := redirectMap["protocol"].(string); protocol != "" {
+				redirectAll.Protocol = aws.String(protocol)
+			}
+			websiteConf.RedirectAllRequestsTo = redirectAll
+		}
+		if ruleList, ok := websiteMap["routing_rule"].([]interface{}); ok {
+			for _, r := range ruleList {
+				ruleMap, _ := r.(map[string]interface{})
+				rule := &s3.RoutingRule{Redirect: &s3.Redirect{}}
+				if conditionList, ok := ruleMap["condition"].([]interface{}); ok && len(conditionList) > 0 && conditionList[0] != nil {
+					conditionMap := conditionList[0].(map[string]interface{})
+					rule.Condition = &s3.Condition{}
+					if code := conditionMap["http_error_code_returned_equals"].(string); code != "" {
+						rule.Condition.HttpErrorCodeReturnedEquals = aws.String(code)
+					}
+					if prefix := conditionMap["key_prefix_equals"].(string); prefix != "" {
+						rule.Condition.KeyPrefixEquals = aws.String(prefix)
+					}
+				}
+				if redirectList, ok := ruleMap["redirect"].([]interface{}); ok && len(redirectList) > 0 && redirectList[0] != nil {
+					redirectMap := redirectList[0].(map[string]interface{})
+					if v := redirectMap["host_name"].(string); v != "" {
+						rule.Redirect.HostName = aws.String(v)
+					}
+					if v := redirectMap["http_redirect_code"].(string); v != "" {
+						rule.Redirect.HttpRedirectCode = aws.String(v)
+					}
+					if v := redirectMap["protocol"].(string); v != "" {
+						rule.Redirect.Protocol = aws.String(v)
+					}
+					if v := redirectMap["replace_key_prefix_with"].(string); v != "" {
+						rule.Redirect.ReplaceKeyPrefixWith = aws.String(v)
+					}
+					if v := redirectMap["replace_key_with"].(string); v != "" {
+						rule.Redirect.ReplaceKeyWith = aws.String(v)
+					}
+				}
+				websiteConf.RoutingRules = append(websiteConf.RoutingRules, rule)
+			}
+		}
+	}
+	return websiteConf
+}
+
+func objectLockConfiguration(objectLockList []interface{}) *s3.ObjectLockConfiguration {
+	objectLockConf := &s3.ObjectLockConfiguration{}
+	for _, l := range objectLockList {
+		objectLockMap, _ := l.(map[string]interface{})
+		objectLockConf.ObjectLockEnabled = aws.String(objectLockMap["object_lock_enabled"].(string))
+		if ruleList, ok := objectLockMap["object_lock_rule"].([]interface{}); ok && len(ruleList) > 0 && ruleList[0] != nil {
+			ruleMap := ruleList[0].(map[string]interface{})
+			retentionList := ruleMap["default_retention"].([]interface{})
+			if len(retentionList) > 0 && retentionList[0] != nil {
+				retentionMap := retentionList[0].(map[string]interface{})
+				retention := &s3.DefaultRetention{
+					Mode: aws.String(retentionMap["mode"].(string)),
+				}
+				if days := retentionMap["days"].(int); days > 0 {
+					retention.Days = aws.Int64(int64(days))
+				}
+				if years := retentionMap["years"].(int); years > 0 {
+					retention.Years = aws.Int64(int64(years))
+				}
+				objectLockConf.Rule = &s3.ObjectLockRule{DefaultRetention: retention}
+			}
+		}
+	}
+	return objectLockConf
+}
+
+func corsRuleList(corsList []interface{}) []*s3.CORSRule {
+	var rules []*s3.CORSRule
+	for _, l := range corsList {
+		corsMap, _ := l.(map[string]interface{})
+		rule := &s3.CORSRule{
+			AllowedHeaders: aws.StringSlice(flex.ExpandStringList(corsMap["allowed_headers"].([]interface{}))),
+			AllowedMethods: aws.StringSlice(flex.ExpandStringList(corsMap["allowed_methods"].([]interface{}))),
+			AllowedOrigins: aws.StringSlice(flex.ExpandStringList(corsMap["allowed_origins"].([]interface{}))),
+			ExposeHeaders:  aws.StringSlice(flex.ExpandStringList(corsMap["expose_headers"].([]interface{}))),
+		}
+		if maxAge := corsMap["max_age_seconds"].(int); maxAge > 0 {
+			rule.MaxAgeSeconds = aws.Int64(int64(maxAge))
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
 func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	var s3Conf *aws.Config
 	rsConClient, err := meta.(conns.ClientSession).BluemixSession()
@@ -850,6 +1169,88 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	//update the object lock configuration, object versioning must already be enabled
+	if d.HasChange("object_lock_configuration") {
+		if objectLock, ok := d.GetOk("object_lock_configuration"); ok {
+			versionPtr, err := s3Client.GetBucketVersioning(&s3.GetBucketVersioningInput{
+				Bucket: aws.String(bucketName),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to read the object versioning of COS bucket %s, %v", bucketName, err)
+			}
+			if versionPtr.Status == nil || *versionPtr.Status != "Enabled" {
+				return fmt.Errorf("[ERROR] object_lock_configuration requires versioning to be enabled on COS bucket %s, enable object_versioning or create an ibm_cos_bucket_versioning resource first", bucketName)
+			}
+			oInput := &s3.PutObjectLockConfigurationInput{
+				Bucket:                  aws.String(bucketName),
+				ObjectLockConfiguration: objectLockConfiguration(objectLock.([]interface{})),
+			}
+			_, err = s3Client.PutObjectLockConfiguration(oInput)
+			if err != nil {
+				return fmt.Errorf("failed to update the object lock configuration on COS bucket %s, %v", bucketName, err)
+			}
+		}
+	}
+
+	//update the static website configuration
+	if d.HasChange("website_configuration") {
+		if website, ok := d.GetOk("website_configuration"); ok {
+			wInput := &s3.PutBucketWebsiteInput{
+				Bucket:               aws.String(bucketName),
+				WebsiteConfiguration: websiteConfiguration(website.([]interface{})),
+			}
+			_, err := s3Client.PutBucketWebsite(wInput)
+			if err != nil {
+				return fmt.Errorf("failed to update the website configuration on COS bucket %s, %v", bucketName, err)
+			}
+		} else {
+			_, err := s3Client.DeleteBucketWebsite(&s3.DeleteBucketWebsiteInput{
+				Bucket: aws.String(bucketName),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to delete the website configuration on COS bucket %s, %v", bucketName, err)
+			}
+		}
+	}
+
+	//update the CORS rules
+	if d.HasChange("cors_rule") {
+		if cors, ok := d.GetOk("cors_rule"); ok {
+			cInput := &s3.PutBucketCorsInput{
+				Bucket: aws.String(bucketName),
+				CORSConfiguration: &s3.CORSConfiguration{
+					CORSRules: corsRuleList(cors.([]interface{})),
+				},
+			}
+			_, err := s3Client.PutBucketCors(cInput)
+			if err != nil {
+				return fmt.Errorf("failed to update the CORS rules on COS bucket %s, %v", bucketName, err)
+			}
+		} else {
+			_, err := s3Client.DeleteBucketCors(&s3.DeleteBucketCorsInput{
+				Bucket: aws.String(bucketName),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to delete the CORS rules on COS bucket %s, %v", bucketName, err)
+			}
+		}
+	}
+
+	//update the public access, only when it is set in the configuration
+	if d.HasChange("public_access") && !d.GetRawConfig().GetAttr("public_access").IsNull() {
+		acl := s3.BucketCannedACLPrivate
+		if d.Get("public_access").(bool) {
+			acl = s3.BucketCannedACLPublicRead
+		}
+		_, err := s3Client.PutBucketAcl(&s3.PutBucketAclInput{
+			Bucket: aws.String(bucketName),
+			ACL:    aws.String(acl),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update the public access on COS bucket %s, %v", bucketName, err)
+		}
+	}
+
 	sess, err := meta.(conns.ClientSession).CosConfigV1API()
 	if err != nil {
 		return err
@@ -956,6 +1357,27 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
+	return readIBMCOSBucket(d, meta, false)
+}
+
+// resourceIBMCOSBucketImport reads every configuration of the bucket, including the ones
+// Read skips while they are not set on the bucket resource
+func resourceIBMCOSBucketImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := readIBMCOSBucket(d, meta, true); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// readIBMCOSBucket reads the bucket. The lifecycle rules, the object versioning and the
+// monitoring can be managed by the standalone ibm_cos_bucket_lifecycle_configuration,
+// ibm_cos_bucket_versioning and ibm_cos_bucket_monitoring resources instead, so they are
+// only read when they are set on the bucket resource, or when readAll is set on import.
+func readIBMCOSBucket(d *schema.ResourceData, meta interface{}, readAll bool) error {
+	inlineSet := func(key string) bool {
+		return readAll || len(d.Get(key).([]interface{})) > 0
+	}
+
 	var s3Conf *aws.Config
 	rsConClient, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
@@ -1102,10 +1524,10 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 			d.Set("allowed_ip", []string{})
 		}
-		if bucketPtr.ActivityTracking != nil {
+		if bucketPtr.ActivityTracking != nil && inlineSet("activity_tracking") {
 			d.Set("activity_tracking", flex.FlattenActivityTrack(bucketPtr.ActivityTracking))
 		}
-		if bucketPtr.MetricsMonitoring != nil {
+		if bucketPtr.MetricsMonitoring != nil && inlineSet("metrics_monitoring") {
 			d.Set("metrics_monitoring", flex.FlattenMetricsMonitor(bucketPtr.MetricsMonitoring))
 		}
 		if bucketPtr.HardQuota != nil {
@@ -1130,16 +1552,16 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 		expireRules := flex.ExpireRuleGet(lifecycleptr.Rules)
 		nc_expRules := flex.Nc_exp_RuleGet(lifecycleptr.Rules)
 		abort_mpuRules := flex.Abort_mpu_RuleGet(lifecycleptr.Rules)
-		if len(archiveRules) > 0 {
+		if len(archiveRules) > 0 && inlineSet("archive_rule") {
 			d.Set("archive_rule", archiveRules)
 		}
-		if len(expireRules) > 0 {
+		if len(expireRules) > 0 && inlineSet("expire_rule") {
 			d.Set("expire_rule", expireRules)
 		}
-		if len(nc_expRules) > 0 {
+		if len(nc_expRules) > 0 && inlineSet("noncurrent_version_expiration") {
 			d.Set("noncurrent_version_expiration", nc_expRules)
 		}
-		if len(abort_mpuRules) > 0 {
+		if len(abort_mpuRules) > 0 && inlineSet("abort_incomplete_multipart_upload_days") {
 			d.Set("abort_incomplete_multipart_upload_days", abort_mpuRules)
 		}
 	}
@@ -1171,7 +1593,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied") {
 		return err
 	}
-	if versionPtr != nil {
+	if versionPtr != nil && inlineSet("object_versioning") {
 		versioningData := flex.FlattenCosObejctVersioning(versionPtr)
 
 		if len(versioningData) > 0 {
@@ -1180,6 +1602,60 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("object_versioning", nil)
 		}
 	}
+
+	// Read the object lock configuration
+	objectLockInput := &s3.GetObjectLockConfigurationInput{
+		Bucket: aws.String(bucketName),
+	}
+	objectLockPtr, err := s3Client.GetObjectLockConfiguration(objectLockInput)
+	if err != nil && !strings.Contains(err.Error(), "ObjectLockConfigurationNotFoundError") && !(bucketPtr != nil && bucketPtr.Firewall != nil && strings.Contains(err.Error(), "AccessDenied: Access Denied")) {
+		return err
+	}
+	if err == nil {
+		d.Set("object_lock_configuration", flex.FlattenCosObjectLockConfiguration(objectLockPtr.ObjectLockConfiguration))
+	} else if strings.Contains(err.Error(), "ObjectLockConfigurationNotFoundError") {
+		d.Set("object_lock_configuration", nil)
+	}
+
+	// Read the static website configuration
+	websiteInput := &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+	}
+	websitePtr, err := s3Client.GetBucketWebsite(websiteInput)
+	if err != nil && !strings.Contains(err.Error(), "NoSuchWebsiteConfiguration") && !(bucketPtr != nil && bucketPtr.Firewall != nil && strings.Contains(err.Error(), "AccessDenied: Access Denied")) {
+		return err
+	}
+	if err == nil {
+		d.Set("website_configuration", flex.FlattenCosWebsiteConfiguration(websitePtr))
+	} else if strings.Contains(err.Error(), "NoSuchWebsiteConfiguration") {
+		d.Set("website_configuration", nil)
+	}
+
+	// Read the CORS rules
+	corsInput := &s3.GetBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	}
+	corsPtr, err := s3Client.GetBucketCors(corsInput)
+	if err != nil && !strings.Contains(err.Error(), "NoSuchCORSConfiguration") && !(bucketPtr != nil && bucketPtr.Firewall != nil && strings.Contains(err.Error(), "AccessDenied: Access Denied")) {
+		return err
+	}
+	if err == nil {
+		d.Set("cors_rule", flex.FlattenCosCORSRules(corsPtr.CORSRules))
+	} else if strings.Contains(err.Error(), "NoSuchCORSConfiguration") {
+		d.Set("cors_rule", nil)
+	}
+
+	// Read the public access
+	aclInput := &s3.GetBucketAclInput{
+		Bucket: aws.String(bucketName),
+	}
+	aclPtr, err := s3Client.GetBucketAcl(aclInput)
+	if err != nil && !(bucketPtr != nil && bucketPtr.Firewall != nil && strings.Contains(err.Error(), "AccessDenied: Access Denied")) {
+		return err
+	}
+	if aclPtr != nil {
+		d.Set("public_access", flex.CosPublicReadGranted(aclPtr.Grants))
+	}
 	return nil
 }
 
@@ -1555,6 +2031,52 @@ func parseBucketId(id string, info string) string {
 	return ""
 }
 
+// resourceObjectLockValidate checks that a configured object versioning is enabled
+// together with object lock and that object lock is not removed once enabled.
+func resourceObjectLockValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	oldObjectLock, newObjectLock := diff.GetChange("object_lock_configuration")
+	if len(oldObjectLock.([]interface{})) > 0 && len(newObjectLock.([]interface{})) == 0 {
+		return fmt.Errorf("[ERROR] Object lock cannot be disabled once it is enabled on a COS bucket, keep the object_lock_configuration block")
+	}
+	if len(newObjectLock.([]interface{})) == 0 {
+		return nil
+	}
+
+	// Versioning can also be enabled with the standalone ibm_cos_bucket_versioning
+	// resource, so only a configured object_versioning that is not enabled is rejected
+	// here. Update checks the versioning of the bucket before enabling object lock.
+	if versioning, ok := diff.GetOk("object_versioning"); ok {
+		for _, l := range versioning.([]interface{}) {
+			if versioningMap, ok := l.(map[string]interface{}); !ok || !versioningMap["enable"].(bool) {
+				return fmt.Errorf("[ERROR] object_lock_configuration requires object_versioning to be enabled on the COS bucket")
+			}
+		}
+	}
+
+	for _, l := range newObjectLock.([]interface{}) {
+		objectLockMap, _ := l.(map[string]interface{})
+		if objectLockMap == nil {
+			continue
+		}
+		for _, r := range objectLockMap["object_lock_rule"].([]interface{}) {
+			ruleMap, _ := r.(map[string]interface{})
+			if ruleMap == nil {
+				continue
+			}
+			for _, dr := range ruleMap["default_retention"].([]interface{}) {
+				retentionMap, _ := dr.(map[string]interface{})
+				if retentionMap == nil {
+					continue
+				}
+				if (retentionMap["days"].(int) > 0) == (retentionMap["years"].(int) > 0) {
+					return fmt.Errorf("[ERROR] Exactly one of days or years must be set in the default_retention of object_lock_rule")
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func resourceExpiryValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	if expire, ok := diff.GetOk("expire_rule"); ok {
 		expire_list := expire.([]interface{})
@@ -0,0 +1,446 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMCOSBucketLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketLifecycleConfigurationCreate,
+		Read:     resourceIBMCOSBucketLifecycleConfigurationRead,
+		Update:   resourceIBMCOSBucketLifecycleConfigurationUpdate,
+		Delete:   resourceIBMCOSBucketLifecycleConfigurationDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"lifecycle_rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1000,
+				Description: "Lifecycle rules of the bucket. A bucket can have up to 1,000 rules, each with its own prefix filter.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+							Description:  "A unique identifier for the rule. The maximum value is 255 characters.",
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"Enabled", "Disabled"}),
+							Description:  "Whether the rule is applied: Enabled or Disabled",
+						},
+						"filter": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Objects the rule applies to. The rule applies to every object of the bucket when no filter is set.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The rule applies to any objects with keys that match this prefix",
+									},
+								},
+							},
+						},
+						"expiration": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Expire the current version of the objects",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ValidBucketLifecycleTimestamp,
+										Description:  "Date in YYYY-MM-DD format after which the objects expire",
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.ValidateAllowedRangeInt(1, 3650),
+										Description:  "Number of days after which the objects expire",
+									},
+									"expired_object_delete_marker": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Remove expired object delete markers",
+									},
+								},
+							},
+						},
+						"transition": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Archive the objects to another storage class",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ValidBucketLifecycleTimestamp,
+										Description:  "Date in YYYY-MM-DD format after which the objects are archived",
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.ValidateAllowedRangeInt(0, 3650),
+										Description:  "Number of days after which the objects are archived",
+									},
+									"storage_class": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     validate.ValidateAllowedStringValues([]string{"GLACIER", "ACCELERATED", "Glacier", "Accelerated", "glacier", "accelerated"}),
+										DiffSuppressFunc: caseDiffSuppress,
+										Description:      "Storage class the objects transition to: GLACIER or ACCELERATED",
+									},
+								},
+							},
+						},
+						"noncurrent_version_expiration": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Expire the noncurrent versions of the objects",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validate.ValidateAllowedRangeInt(1, 3650),
+										Description:  "Number of days a version is kept after it becomes noncurrent",
+									},
+								},
+							},
+						},
+						"abort_incomplete_multipart_upload": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Abort the multipart uploads that do not complete in time",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days_after_initiation": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validate.ValidateAllowedRangeInt(1, 3650),
+										Description:  "Number of days after which an incomplete multipart upload is aborted",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func lifecycleRuleList(lifecycleList []interface{}) ([]*s3.LifecycleRule, error) {
+	var rules []*s3.LifecycleRule
+	for _, l := range lifecycleList {
+		ruleMap, _ := l.(map[string]interface{})
+		ruleID := ruleMap["rule_id"].(string)
+
+		rule := &s3.LifecycleRule{
+			ID:     aws.String(ruleID),
+			Status: aws.String(ruleMap["status"].(string)),
+			Filter: &s3.LifecycleRuleFilter{},
+		}
+
+		if filterList := ruleMap["filter"].([]interface{}); len(filterList) > 0 && filterList[0] != nil {
+			filterMap := filterList[0].(map[string]interface{})
+			if prefix := filterMap["prefix"].(string); prefix != "" {
+				rule.Filter.Prefix = aws.String(prefix)
+			}
+		}
+
+		if expirationList := ruleMap["expiration"].([]interface{}); len(expirationList) > 0 && expirationList[0] != nil {
+			expirationMap := expirationList[0].(map[string]interface{})
+			expiration := &s3.LifecycleExpiration{}
+			actions := 0
+			if date := expirationMap["date"].(string); date != "" {
+				expireDate, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", date))
+				if err != nil {
+					return nil, fmt.Errorf("[ERROR] Invalid expiration date %s in lifecycle rule %s: %s", date, ruleID, err)
+				}
+				expiration.Date = aws.Time(expireDate)
+				actions++
+			}
+			if days := expirationMap["days"].(int); days > 0 {
+				expiration.Days = aws.Int64(int64(days))
+				actions++
+			}
+			if expirationMap["expired_object_delete_marker"].(bool) {
+				expiration.ExpiredObjectDeleteMarker = aws.Bool(true)
+				actions++
+			}
+			if actions != 1 {
+				return nil, fmt.Errorf("[ERROR] Exactly one of date, days or expired_object_delete_marker must be set in the expiration of lifecycle rule %s", ruleID)
+			}
+			rule.Expiration = expiration
+		}
+
+		for _, t := range ruleMap["transition"].([]interface{}) {
+			transitionMap, _ := t.(map[string]interface{})
+			if transitionMap == nil {
+				continue
+			}
+			transition := &s3.Transition{
+				StorageClass: aws.String(strings.ToUpper(transitionMap["storage_class"].(string))),
+			}
+			if date := transitionMap["date"].(string); date != "" {
+				transitionDate, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", date))
+				if err != nil {
+					return nil, fmt.Errorf("[ERROR] Invalid transition date %s in lifecycle rule %s: %s", date, ruleID, err)
+				}
+				transition.Date = aws.Time(transitionDate)
+			} else {
+				transition.Days = aws.Int64(int64(transitionMap["days"].(int)))
+			}
+			rule.Transitions = append(rule.Transitions, transition)
+		}
+
+		if ncList := ruleMap["noncurrent_version_expiration"].([]interface{}); len(ncList) > 0 && ncList[0] != nil {
+			ncMap := ncList[0].(map[string]interface{})
+			rule.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{
+				NoncurrentDays: aws.Int64(int64(ncMap["noncurrent_days"].(int))),
+			}
+		}
+
+		if abortList := ruleMap["abort_incomplete_multipart_upload"].([]interface{}); len(abortList) > 0 && abortList[0] != nil {
+			abortMap := abortList[0].(map[string]interface{})
+			rule.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(int64(abortMap["days_after_initiation"].(int))),
+			}
+		}
+
+		if rule.Expiration == nil && rule.Transitions == nil && rule.NoncurrentVersionExpiration == nil && rule.AbortIncompleteMultipartUpload == nil {
+			return nil, fmt.Errorf("[ERROR] Lifecycle rule %s must have at least one of expiration, transition, noncurrent_version_expiration or abort_incomplete_multipart_upload", ruleID)
+		}
+
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func resourceIBMCOSBucketLifecycleConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	rules, err := lifecycleRuleList(d.Get("lifecycle_rule").([]interface{}))
+	if err != nil {
+		return err
+	}
+	putBucketLifecycleInput := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucketName),
+		LifecycleConfiguration: &s3.LifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+
+	_, err = s3Client.PutBucketLifecycleConfiguration(putBucketLifecycleInput)
+	if err != nil {
+		return fmt.Errorf("failed to create the lifecycle configuration on COS bucket %s, %v", bucketName, err)
+	}
+
+	//Generating a fake id which contains every information about to get the bucket via s3 api
+	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+	d.SetId(bktID)
+
+	return resourceIBMCOSBucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketLifecycleConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("lifecycle_rule") {
+		rules, err := lifecycleRuleList(d.Get("lifecycle_rule").([]interface{}))
+		if err != nil {
+			return err
+		}
+		putBucketLifecycleInput := &s3.PutBucketLifecycleConfigurationInput{
+			Bucket: aws.String(bucketName),
+			LifecycleConfiguration: &s3.LifecycleConfiguration{
+				Rules: rules,
+			},
+		}
+
+		_, err = s3Client.PutBucketLifecycleConfiguration(putBucketLifecycleInput)
+		if err != nil {
+			return fmt.Errorf("failed to update the lifecycle configuration on COS bucket %s, %v", bucketName, err)
+		}
+	}
+	return resourceIBMCOSBucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketLifecycleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseBucketReplId(d.Id(), "bucketCRN")
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	getBucketLifecycleInput := &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucketName),
+	}
+
+	lifecycleptr, err := s3Client.GetBucketLifecycleConfiguration(getBucketLifecycleInput)
+	if err != nil {
+		if strings.Contains(err.Error(), "NoSuchLifecycleConfiguration") {
+			log.Printf("[WARN] Lifecycle configuration of COS bucket %s not found, removing it from state", bucketName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("lifecycle_rule", normalizeCosLifecycleRuleFilters(d, flex.FlattenCosLifecycleRules(lifecycleptr.Rules)))
+	return nil
+}
+
+// normalizeCosLifecycleRuleFilters keeps a filter block with an empty prefix of the rules in state,
+// COS does not return an empty prefix filter
+func normalizeCosLifecycleRuleFilters(d *schema.ResourceData, rules []interface{}) []interface{} {
+	emptyFilters := make(map[string]bool)
+	for _, r := range d.Get("lifecycle_rule").([]interface{}) {
+		ruleMap, _ := r.(map[string]interface{})
+		if ruleMap == nil {
+			continue
+		}
+		filterList, _ := ruleMap["filter"].([]interface{})
+		if len(filterList) == 0 {
+			continue
+		}
+		if filterMap, _ := filterList[0].(map[string]interface{}); filterMap == nil || filterMap["prefix"].(string) == "" {
+			emptyFilters[ruleMap["rule_id"].(string)] = true
+		}
+	}
+	for _, r := range rules {
+		ruleMap := r.(map[string]interface{})
+		if _, ok := ruleMap["filter"]; !ok && emptyFilters[ruleMap["rule_id"].(string)] {
+			ruleMap["filter"] = []interface{}{
+				map[string]interface{}{
+					"prefix": "",
+				},
+			}
+		}
+	}
+	return rules
+}
+
+func resourceIBMCOSBucketLifecycleConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	deleteBucketLifecycleInput := &s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(bucketName),
+	}
+
+	_, err = s3Client.DeleteBucketLifecycle(deleteBucketLifecycleInput)
+	if err != nil {
+		return err
+	}
+	return nil
+}
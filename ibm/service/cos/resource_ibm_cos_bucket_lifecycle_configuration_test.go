@@ -0,0 +1,97 @@
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosBucket_Lifecycle_Configuration(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-lifecycle%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_lifecycle_configuration(serviceName, bucketName, bucketRegion, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_lifecycle_configuration.lifecycle", "lifecycle_rule.#", "2"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_lifecycle_configuration.lifecycle", "lifecycle_rule.0.rule_id", "expire-logs"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_lifecycle_configuration.lifecycle", "lifecycle_rule.0.filter.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_lifecycle_configuration.lifecycle", "lifecycle_rule.0.expiration.0.days", "30"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_lifecycle_configuration.lifecycle", "lifecycle_rule.1.transition.0.storage_class", "GLACIER"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_lifecycle_configuration.lifecycle", "lifecycle_rule.1.abort_incomplete_multipart_upload.0.days_after_initiation", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_lifecycle_configuration(serviceName, bucketName, bucketRegion, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_lifecycle_configuration.lifecycle", "lifecycle_rule.#", "2"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_lifecycle_configuration.lifecycle", "lifecycle_rule.0.expiration.0.days", "60"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cos_bucket_lifecycle_configuration.lifecycle",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucket_lifecycle_configuration(serviceName, bucketName, region string, days int) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		is_default=true
+	}
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%s"
+		storage_class        = "standard"
+	}
+	resource "ibm_cos_bucket_lifecycle_configuration" "lifecycle" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.region_location
+		lifecycle_rule {
+			rule_id = "expire-logs"
+			status  = "Enabled"
+			filter {
+				prefix = "logs/"
+			}
+			expiration {
+				days = %d
+			}
+		}
+		lifecycle_rule {
+			rule_id = "archive-data"
+			status  = "Enabled"
+			filter {
+				prefix = "data/"
+			}
+			transition {
+				days          = 10
+				storage_class = "GLACIER"
+			}
+			abort_incomplete_multipart_upload {
+				days_after_initiation = 2
+			}
+		}
+	}
+	`, serviceName, bucketName, region, days)
+}
@@ -0,0 +1,278 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go-config/resourceconfigurationv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMCOSBucketMonitoring() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketMonitoringCreate,
+		Read:     resourceIBMCOSBucketMonitoringRead,
+		Update:   resourceIBMCOSBucketMonitoringUpdate,
+		Delete:   resourceIBMCOSBucketMonitoringDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"activity_tracking": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"activity_tracking", "metrics_monitoring"},
+				Description:  "Enables sending log data to Activity Tracker and LogDNA to provide visibility into object read and write events",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"read_data_events": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "If set to true, all object read events will be sent to Activity Tracker.",
+						},
+						"write_data_events": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "If set to true, all object write events will be sent to Activity Tracker.",
+						},
+						"activity_tracker_crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The instance of Activity Tracker that will receive object event data",
+						},
+					},
+				},
+			},
+			"metrics_monitoring": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"activity_tracking", "metrics_monitoring"},
+				Description:  "Enables sending metrics to IBM Cloud Monitoring.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"usage_metrics_enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Usage metrics will be sent to the monitoring service.",
+						},
+						"request_metrics_enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Request metrics will be sent to the monitoring service.",
+						},
+						"metrics_monitoring_crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Instance of IBM Cloud Monitoring that will receive the bucket metrics.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getCOSBucketConfigSession(meta interface{}, endpointType string) (*resourceconfigurationv1.ResourceConfigurationV1, error) {
+	sess, err := meta.(conns.ClientSession).CosConfigV1API()
+	if err != nil {
+		return nil, err
+	}
+	if endpointType == "private" {
+		sess.SetServiceURL("https://config.private.cloud-object-storage.cloud.ibm.com/v1")
+	}
+	return sess, nil
+}
+
+func activityTracking(activityList []interface{}) *resourceconfigurationv1.ActivityTracking {
+	activityTracker := &resourceconfigurationv1.ActivityTracking{}
+	for _, l := range activityList {
+		activityMap, _ := l.(map[string]interface{})
+		readSet := activityMap["read_data_events"].(bool)
+		activityTracker.ReadDataEvents = &readSet
+		writeSet := activityMap["write_data_events"].(bool)
+		activityTracker.WriteDataEvents = &writeSet
+		crn := activityMap["activity_tracker_crn"].(string)
+		activityTracker.ActivityTrackerCrn = &crn
+	}
+	return activityTracker
+}
+
+func metricsMonitoring(metricsList []interface{}) *resourceconfigurationv1.MetricsMonitoring {
+	metricsMonitor := &resourceconfigurationv1.MetricsMonitoring{}
+	for _, l := range metricsList {
+		metricsMap, _ := l.(map[string]interface{})
+		usage := metricsMap["usage_metrics_enabled"].(bool)
+		metricsMonitor.UsageMetricsEnabled = &usage
+		request := metricsMap["request_metrics_enabled"].(bool)
+		metricsMonitor.RequestMetricsEnabled = &request
+		crn := metricsMap["metrics_monitoring_crn"].(string)
+		metricsMonitor.MetricsMonitoringCrn = &crn
+	}
+	return metricsMonitor
+}
+
+func resourceIBMCOSBucketMonitoringCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	sess, err := getCOSBucketConfigSession(meta, endpointType)
+	if err != nil {
+		return err
+	}
+
+	updateBucketConfigOptions := &resourceconfigurationv1.UpdateBucketConfigOptions{
+		Bucket: &bucketName,
+	}
+	if activity, ok := d.GetOk("activity_tracking"); ok {
+		updateBucketConfigOptions.ActivityTracking = activityTracking(activity.([]interface{}))
+	}
+	if metrics, ok := d.GetOk("metrics_monitoring"); ok {
+		updateBucketConfigOptions.MetricsMonitoring = metricsMonitoring(metrics.([]interface{}))
+	}
+	response, err := sess.UpdateBucketConfig(updateBucketConfigOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Update COS Bucket monitoring: %s\n%s", err, response)
+	}
+
+	//Generating a fake id which contains every information about to get the bucket via s3 api
+	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+	d.SetId(bktID)
+
+	return resourceIBMCOSBucketMonitoringRead(d, meta)
+}
+
+func resourceIBMCOSBucketMonitoringUpdate(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	endpointType := d.Get("endpoint_type").(string)
+
+	sess, err := getCOSBucketConfigSession(meta, endpointType)
+	if err != nil {
+		return err
+	}
+
+	hasChanged := false
+	updateBucketConfigOptions := &resourceconfigurationv1.UpdateBucketConfigOptions{
+		Bucket: &bucketName,
+	}
+	if d.HasChange("activity_tracking") {
+		hasChanged = true
+		updateBucketConfigOptions.ActivityTracking = activityTracking(d.Get("activity_tracking").([]interface{}))
+	}
+	if d.HasChange("metrics_monitoring") {
+		hasChanged = true
+		updateBucketConfigOptions.MetricsMonitoring = metricsMonitoring(d.Get("metrics_monitoring").([]interface{}))
+	}
+
+	if hasChanged {
+		response, err := sess.UpdateBucketConfig(updateBucketConfigOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Update COS Bucket monitoring: %s\n%s", err, response)
+		}
+	}
+	return resourceIBMCOSBucketMonitoringRead(d, meta)
+}
+
+func resourceIBMCOSBucketMonitoringRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseBucketReplId(d.Id(), "bucketCRN")
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+
+	sess, err := getCOSBucketConfigSession(meta, endpointType)
+	if err != nil {
+		return err
+	}
+
+	getBucketConfigOptions := &resourceconfigurationv1.GetBucketConfigOptions{
+		Bucket: &bucketName,
+	}
+	bucketPtr, response, err := sess.GetBucketConfig(getBucketConfigOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error in getting bucket info rule: %s\n%s", err, response)
+	}
+
+	// the configuration API returns an empty block once a service is disconnected
+	if bucketPtr.ActivityTracking != nil && bucketPtr.ActivityTracking.ActivityTrackerCrn != nil {
+		d.Set("activity_tracking", flex.FlattenActivityTrack(bucketPtr.ActivityTracking))
+	} else {
+		d.Set("activity_tracking", []interface{}{})
+	}
+	if bucketPtr.MetricsMonitoring != nil && bucketPtr.MetricsMonitoring.MetricsMonitoringCrn != nil {
+		d.Set("metrics_monitoring", flex.FlattenMetricsMonitor(bucketPtr.MetricsMonitoring))
+	} else {
+		d.Set("metrics_monitoring", []interface{}{})
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketMonitoringDelete(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	sess, err := getCOSBucketConfigSession(meta, endpointType)
+	if err != nil {
+		return err
+	}
+
+	// Only disconnect the services managed by this resource
+	updateBucketConfigOptions := &resourceconfigurationv1.UpdateBucketConfigOptions{
+		Bucket: &bucketName,
+	}
+	if _, ok := d.GetOk("activity_tracking"); ok {
+		updateBucketConfigOptions.ActivityTracking = &resourceconfigurationv1.ActivityTracking{}
+	}
+	if _, ok := d.GetOk("metrics_monitoring"); ok {
+		updateBucketConfigOptions.MetricsMonitoring = &resourceconfigurationv1.MetricsMonitoring{}
+	}
+	response, err := sess.UpdateBucketConfig(updateBucketConfigOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Update COS Bucket monitoring: %s\n%s", err, response)
+	}
+	return nil
+}
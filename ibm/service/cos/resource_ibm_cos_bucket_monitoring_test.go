@@ -0,0 +1,86 @@
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosBucket_Monitoring(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	activityServiceName := fmt.Sprintf("activity_tracker_%d", acctest.RandIntRange(10, 100))
+	monitorServiceName := fmt.Sprintf("metrics_monitor_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-monitoring%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_monitoring(serviceName, activityServiceName, monitorServiceName, bucketName, bucketRegion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_monitoring.monitoring", "activity_tracking.0.write_data_events", "true"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_monitoring.monitoring", "metrics_monitoring.0.request_metrics_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucket_monitoring(serviceName, activityServiceName, monitorServiceName, bucketName, region string) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		is_default=true
+	}
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+	}
+	resource "ibm_resource_instance" "activity_tracker" {
+		name              = "%s"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+		service           = "logdnaat"
+		plan              = "7-day"
+		location          = "us-south"
+	}
+	resource "ibm_resource_instance" "metrics_monitor" {
+		name              = "%s"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+		service           = "sysdig-monitor"
+		plan              = "graduated-tier"
+		location          = "us-south"
+		parameters        = {
+			default_receiver = true
+		}
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%s"
+		storage_class        = "standard"
+	}
+	resource "ibm_cos_bucket_monitoring" "monitoring" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.region_location
+		activity_tracking {
+			read_data_events     = true
+			write_data_events    = true
+			activity_tracker_crn = ibm_resource_instance.activity_tracker.id
+		}
+		metrics_monitoring {
+			usage_metrics_enabled   = true
+			request_metrics_enabled = true
+			metrics_monitoring_crn  = ibm_resource_instance.metrics_monitor.id
+		}
+	}
+	`, serviceName, activityServiceName, monitorServiceName, bucketName, region)
+}
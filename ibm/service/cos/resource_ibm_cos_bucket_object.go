@@ -6,10 +6,13 @@ package cos
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
+	"net/url"
 	"os"
 	"regexp"
 	"strings"
@@ -17,6 +20,7 @@ import (
 
 	bxsession "github.com/IBM-Cloud/bluemix-go/session"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
@@ -24,10 +28,21 @@ import (
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const cosObjectPartSizeUnit = 1024 * 1024
+
+// cosObjectGetter is satisfied by both schema.ResourceData and schema.ResourceDiff
+// so that the object content can be opened at plan and at apply time.
+type cosObjectGetter interface {
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+}
+
 func ResourceIBMCOSBucketObject() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMCOSBucketObjectCreate,
@@ -36,6 +51,8 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 		DeleteContext: resourceIBMCOSBucketObjectDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: resourceIBMCOSBucketObjectCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(20 * time.Minute),
@@ -126,6 +143,83 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Computed:    true,
 				Description: "Access the object using an SQL Query instance.The reference url is used to perform queries against objects storing structured data.",
 			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      16,
+				ValidateFunc: validation.IntBetween(5, 5120),
+				Description:  "Size in MiB of each part when the object is uploaded in multiple parts. Content larger than one part is uploaded with a multipart upload.",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3manager.DefaultUploadConcurrency,
+				ValidateFunc: validation.IntBetween(1, 32),
+				Description:  "Number of parts uploaded in parallel during a multipart upload",
+			},
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{s3.ServerSideEncryptionAes256}),
+				Description:  "Server-side encryption algorithm used to store the object: AES256",
+			},
+			"sse_customer_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"sse_customer_key"},
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{s3.ServerSideEncryptionAes256}),
+				Description:  "Algorithm used to encrypt the object with a customer-provided key: AES256",
+			},
+			"sse_customer_key": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				RequiredWith:     []string{"sse_customer_algorithm"},
+				DiffSuppressFunc: flex.SuppressSaltedHashedSecret,
+				Description:      "Base64-encoded 256-bit customer-provided key used to encrypt the object. The key is not stored in state, only its hash.",
+			},
+			"metadata": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validation.MapKeyMatch(regexp.MustCompile(`^[0-9a-z-]+$`), "metadata keys must contain only lowercase letters, digits and hyphens"),
+				Description:      "User-defined metadata stored with the object as x-amz-meta- headers",
+			},
+			"object_lock_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"object_lock_retain_until_date"},
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{s3.ObjectLockModeCompliance}),
+				Description:  "Retention mode of the object: COMPLIANCE. The bucket must have object lock enabled.",
+			},
+			"object_lock_retain_until_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"object_lock_mode"},
+				ValidateFunc: validation.IsRFC3339Time,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					oldTime, oldErr := time.Parse(time.RFC3339, old)
+					newTime, newErr := time.Parse(time.RFC3339, new)
+					return oldErr == nil && newErr == nil && oldTime.Equal(newTime)
+				},
+				Description: "Date and time in RFC3339 format until which the object is retained. It can only be extended.",
+			},
+			"object_lock_legal_hold_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{s3.ObjectLockLegalHoldStatusOn, s3.ObjectLockLegalHoldStatusOff}),
+				Description:  "Legal hold status of the object: ON or OFF. The bucket must have object lock enabled.",
+			},
+			"object_tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tags attached to the object",
+			},
 		},
 	}
 }
@@ -160,42 +254,8 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("[ERROR] Error COS bucket (%s) object (%s) already exists", bucketName, objectKey))
 	}
 
-	var body io.ReadSeeker
-
-	if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
-		}
-		body = bytes.NewReader(contentRaw)
-	} else if v, ok := d.GetOk("content_file"); ok {
-		path := v.(string)
-		file, err := os.Open(path)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
-		}
-
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-			}
-		}()
-	}
-
-	putInput := &s3.PutObjectInput{
-		Bucket: aws.String(bucketName),
-		Key:    aws.String(objectKey),
-		Body:   body,
-	}
-
-	if _, err := s3Client.PutObject(putInput); err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+	if err := uploadCOSObject(ctx, s3Client, d, bucketName, objectKey); err != nil {
+		return diag.FromErr(err)
 	}
 
 	objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
@@ -231,6 +291,16 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 		Bucket: aws.String(bucketName),
 		Key:    aws.String(objectKey),
 	}
+	if _, ok := d.GetOk("sse_customer_key"); ok {
+		sseCustomerKey := cosObjectSSECustomerKey(d)
+		if sseCustomerKey == "" {
+			// Only the hash of the customer-provided key is in state, without the key
+			// the object cannot be read, so only its existence is refreshed
+			return resourceIBMCOSBucketObjectExistsRead(d, s3Client, bucketName, objectKey)
+		}
+		headInput.SSECustomerAlgorithm = aws.String(d.Get("sse_customer_algorithm").(string))
+		headInput.SSECustomerKey = aws.String(sseCustomerKey)
+	}
 
 	out, err := s3Client.HeadObject(headInput)
 	if err != nil {
@@ -253,8 +323,10 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 
 	if isContentTypeAllowed(out.ContentType) {
 		getInput := s3.GetObjectInput{
-			Bucket: aws.String(bucketName),
-			Key:    aws.String(objectKey),
+			Bucket:               aws.String(bucketName),
+			Key:                  aws.String(objectKey),
+			SSECustomerAlgorithm: headInput.SSECustomerAlgorithm,
+			SSECustomerKey:       headInput.SSECustomerKey,
 		}
 		out, err := s3Client.GetObject(&getInput)
 		if err != nil {
@@ -279,14 +351,79 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 		log.Printf("[INFO] Ignoring body of COS bucket (%s) object (%s) with Content-Type %q", bucketName, objectKey, contentType)
 	}
 
+	d.Set("server_side_encryption", out.ServerSideEncryption)
+	d.Set("object_lock_mode", out.ObjectLockMode)
+	if out.ObjectLockRetainUntilDate != nil {
+		d.Set("object_lock_retain_until_date", out.ObjectLockRetainUntilDate.Format(time.RFC3339))
+	} else {
+		d.Set("object_lock_retain_until_date", "")
+	}
+	d.Set("object_lock_legal_hold_status", out.ObjectLockLegalHoldStatus)
+	metadata := make(map[string]string, len(out.Metadata))
+	for k, v := range out.Metadata {
+		metadata[strings.ToLower(k)] = aws.StringValue(v)
+	}
+	d.Set("metadata", metadata)
+
+	tagging, err := s3Client.GetObjectTagging(&s3.GetObjectTaggingInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed getting tags of COS bucket (%s) object (%s): %w", bucketName, objectKey, err))
+	}
+	tags := make(map[string]string, len(tagging.TagSet))
+	for _, tag := range tagging.TagSet {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	d.Set("object_tags", tags)
+
 	d.Set("key", objectKey)
 	d.Set("version_id", out.VersionId)
 	d.Set("object_sql_url", "cos://"+bucketLocation+"/"+bucketName+"/"+objectKey)
+	if err := flex.SetHashedSecret(d, "sse_customer_key"); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceIBMCOSBucketObjectExistsRead removes an object encrypted with a customer-provided key
+// from state when it no longer exists
+func resourceIBMCOSBucketObjectExistsRead(d *schema.ResourceData, s3Client *s3.S3, bucketName, objectKey string) diag.Diagnostics {
+	out, err := s3Client.ListObjectsV2(&s3.ListObjectsV2Input{
+		Bucket:  aws.String(bucketName),
+		Prefix:  aws.String(objectKey),
+		MaxKeys: aws.Int64(1),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed listing COS bucket (%s) object (%s): %w", bucketName, objectKey, err))
+	}
+	if len(out.Contents) == 0 || aws.StringValue(out.Contents[0].Key) != objectKey {
+		d.SetId("")
+		return nil
+	}
+	d.Set("key", objectKey)
 	return nil
 }
 
+// cosObjectSSECustomerKey returns the configured customer-provided key. The state only holds its hash,
+// so the key is not available when the object is refreshed without a configuration.
+func cosObjectSSECustomerKey(d *schema.ResourceData) string {
+	if raw := d.GetRawConfig(); !raw.IsNull() {
+		if v := raw.GetAttr("sse_customer_key"); v.IsKnown() && !v.IsNull() {
+			return v.AsString()
+		}
+	}
+	key := d.Get("sse_customer_key").(string)
+	if flex.IsHashedSecret(key) {
+		return ""
+	}
+	return key
+}
+
 func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	if d.HasChanges("content", "content_base64", "content_file", "etag") {
+	upload := d.HasChanges("content", "content_base64", "content_file", "etag", "metadata", "server_side_encryption", "sse_customer_algorithm", "sse_customer_key")
+	if upload || d.HasChanges("object_tags", "object_lock_mode", "object_lock_retain_until_date", "object_lock_legal_hold_status") {
 		bucketCRN := d.Get("bucket_crn").(string)
 		bucketName := strings.Split(bucketCRN, ":bucket:")[1]
 		instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
@@ -304,44 +441,30 @@ func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceDat
 			return diag.FromErr(err)
 		}
 
-		var body io.ReadSeeker
+		objectKey := d.Get("key").(string)
 
-		if v, ok := d.GetOk("content"); ok {
-			content := v.(string)
-			body = bytes.NewReader([]byte(content))
-		} else if v, ok := d.GetOk("content_base64"); ok {
-			content := v.(string)
-			contentRaw, err := base64.StdEncoding.DecodeString(content)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
+		// Tags and object lock settings are sent with the upload, so they only
+		// need separate calls when the content itself is unchanged.
+		if upload {
+			if err := uploadCOSObject(ctx, s3Client, d, bucketName, objectKey); err != nil {
+				return diag.FromErr(err)
 			}
-			body = bytes.NewReader(contentRaw)
-		} else if v, ok := d.GetOk("content_file"); ok {
-			path := v.(string)
-			file, err := os.Open(path)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
+		} else {
+			if d.HasChange("object_tags") {
+				if err := putCOSObjectTags(s3Client, bucketName, objectKey, d.Get("object_tags").(map[string]interface{})); err != nil {
+					return diag.FromErr(err)
+				}
 			}
-
-			body = file
-			defer func() {
-				err := file.Close()
-				if err != nil {
-					log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
+			if d.HasChanges("object_lock_mode", "object_lock_retain_until_date") {
+				if err := putCOSObjectRetention(s3Client, d, bucketName, objectKey); err != nil {
+					return diag.FromErr(err)
 				}
-			}()
-		}
-
-		objectKey := d.Get("key").(string)
-
-		putInput := &s3.PutObjectInput{
-			Bucket: aws.String(bucketName),
-			Key:    aws.String(objectKey),
-			Body:   body,
-		}
-
-		if _, err := s3Client.PutObject(putInput); err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+			}
+			if d.HasChange("object_lock_legal_hold_status") {
+				if err := putCOSObjectLegalHold(s3Client, d, bucketName, objectKey); err != nil {
+					return diag.FromErr(err)
+				}
+			}
 		}
 
 		objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
@@ -382,6 +505,237 @@ func resourceIBMCOSBucketObjectDelete(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+func resourceIBMCOSBucketObjectCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// A configured etag is compared as is, and a new object has no etag to
+	// compare against yet.
+	if diff.Id() == "" || !diff.GetRawConfig().GetAttr("etag").IsNull() {
+		return nil
+	}
+	// Objects encrypted with a customer-provided key do not have an MD5 ETag.
+	if _, ok := diff.GetOk("sse_customer_key"); ok {
+		return nil
+	}
+	for _, key := range []string{"content", "content_base64", "content_file"} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	body, closeBody, err := openCOSObjectContent(diff)
+	if err != nil {
+		log.Printf("[WARN] Skipping ETag comparison for COS object %s: %s", diff.Id(), err)
+		return nil
+	}
+	defer closeBody()
+
+	etag, err := cosObjectETag(body, int64(diff.Get("part_size").(int))*cosObjectPartSizeUnit)
+	if err != nil {
+		return err
+	}
+	if etag != diff.Get("etag").(string) {
+		log.Printf("[INFO] COS object %s content changed, ETag %q will become %q", diff.Id(), diff.Get("etag").(string), etag)
+		return diff.SetNew("etag", etag)
+	}
+	return nil
+}
+
+// openCOSObjectContent returns a reader over the configured object content.
+// Files are opened rather than read so that they can be streamed.
+func openCOSObjectContent(d cosObjectGetter) (io.ReadSeeker, func(), error) {
+	if v, ok := d.GetOk("content"); ok {
+		return strings.NewReader(v.(string)), func() {}, nil
+	}
+	if v, ok := d.GetOk("content_base64"); ok {
+		contentRaw, err := base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return nil, nil, fmt.Errorf("[ERROR] Error decoding content_base64: %s", err)
+		}
+		return bytes.NewReader(contentRaw), func() {}, nil
+	}
+	if v, ok := d.GetOk("content_file"); ok {
+		path := v.(string)
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err)
+		}
+		return file, func() {
+			if err := file.Close(); err != nil {
+				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
+			}
+		}, nil
+	}
+	return bytes.NewReader(nil), func() {}, nil
+}
+
+// uploadCOSObject streams the configured content to COS. Content larger than
+// part_size is sent as a multipart upload with upload_concurrency parts in flight.
+func uploadCOSObject(ctx context.Context, s3Client *s3.S3, d *schema.ResourceData, bucketName, objectKey string) error {
+	body, closeBody, err := openCOSObjectContent(d)
+	if err != nil {
+		return err
+	}
+	defer closeBody()
+
+	uploadInput := &s3manager.UploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+		Body:   body,
+	}
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		uploadInput.ServerSideEncryption = aws.String(v.(string))
+	}
+	if _, ok := d.GetOk("sse_customer_key"); ok {
+		uploadInput.SSECustomerAlgorithm = aws.String(d.Get("sse_customer_algorithm").(string))
+		uploadInput.SSECustomerKey = aws.String(cosObjectSSECustomerKey(d))
+	}
+	if v, ok := d.GetOk("metadata"); ok {
+		uploadInput.Metadata = make(map[string]*string)
+		for k, val := range v.(map[string]interface{}) {
+			uploadInput.Metadata[k] = aws.String(val.(string))
+		}
+	}
+	if v, ok := d.GetOk("object_tags"); ok {
+		uploadInput.Tagging = aws.String(encodeCOSObjectTags(v.(map[string]interface{})))
+	}
+	if v, ok := d.GetOk("object_lock_mode"); ok {
+		retainUntil, err := time.Parse(time.RFC3339, d.Get("object_lock_retain_until_date").(string))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error parsing object_lock_retain_until_date: %s", err)
+		}
+		uploadInput.ObjectLockMode = aws.String(v.(string))
+		uploadInput.ObjectLockRetainUntilDate = aws.Time(retainUntil)
+	}
+	if v, ok := d.GetOk("object_lock_legal_hold_status"); ok {
+		uploadInput.ObjectLockLegalHoldStatus = aws.String(v.(string))
+	}
+
+	uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+		u.PartSize = int64(d.Get("part_size").(int)) * cosObjectPartSizeUnit
+		u.Concurrency = d.Get("upload_concurrency").(int)
+	})
+	if _, err := uploader.UploadWithContext(ctx, uploadInput); err != nil {
+		return fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+	}
+	return nil
+}
+
+func putCOSObjectTags(s3Client *s3.S3, bucketName, objectKey string, tags map[string]interface{}) error {
+	if len(tags) == 0 {
+		_, err := s3Client.DeleteObjectTagging(&s3.DeleteObjectTaggingInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error removing tags of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+		}
+		return nil
+	}
+
+	tagSet := make([]*s3.Tag, 0, len(tags))
+	for k, v := range tags {
+		tagSet = append(tagSet, &s3.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+	_, err := s3Client.PutObjectTagging(&s3.PutObjectTaggingInput{
+		Bucket:  aws.String(bucketName),
+		Key:     aws.String(objectKey),
+		Tagging: &s3.Tagging{TagSet: tagSet},
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error tagging object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+	}
+	return nil
+}
+
+func putCOSObjectRetention(s3Client *s3.S3, d *schema.ResourceData, bucketName, objectKey string) error {
+	retention := &s3.ObjectLockRetention{}
+	if v, ok := d.GetOk("object_lock_mode"); ok {
+		retainUntil, err := time.Parse(time.RFC3339, d.Get("object_lock_retain_until_date").(string))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error parsing object_lock_retain_until_date: %s", err)
+		}
+		retention.Mode = aws.String(v.(string))
+		retention.RetainUntilDate = aws.Time(retainUntil)
+	}
+	_, err := s3Client.PutObjectRetention(&s3.PutObjectRetentionInput{
+		Bucket:    aws.String(bucketName),
+		Key:       aws.String(objectKey),
+		Retention: retention,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating retention of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+	}
+	return nil
+}
+
+func putCOSObjectLegalHold(s3Client *s3.S3, d *schema.ResourceData, bucketName, objectKey string) error {
+	status := d.Get("object_lock_legal_hold_status").(string)
+	if status == "" {
+		status = s3.ObjectLockLegalHoldStatusOff
+	}
+	_, err := s3Client.PutObjectLegalHold(&s3.PutObjectLegalHoldInput{
+		Bucket:    aws.String(bucketName),
+		Key:       aws.String(objectKey),
+		LegalHold: &s3.ObjectLockLegalHold{Status: aws.String(status)},
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating legal hold of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+	}
+	return nil
+}
+
+func encodeCOSObjectTags(tags map[string]interface{}) string {
+	values := url.Values{}
+	for k, v := range tags {
+		values.Set(k, v.(string))
+	}
+	return values.Encode()
+}
+
+// cosObjectETag computes the ETag COS assigns to content uploaded by s3manager
+// with the given part size: the MD5 of the content for a single part upload,
+// or the MD5 of the concatenated part MD5s suffixed with the part count.
+func cosObjectETag(body io.ReadSeeker, partSize int64) (string, error) {
+	size, err := aws.SeekerLen(body)
+	if err != nil {
+		return "", err
+	}
+	// s3manager grows the part size when the content would not fit in the
+	// maximum number of parts.
+	if size/partSize >= int64(s3manager.MaxUploadParts) {
+		partSize = size/int64(s3manager.MaxUploadParts) + 1
+	}
+
+	if size <= partSize {
+		hash := md5.New()
+		if _, err := io.Copy(hash, body); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(hash.Sum(nil)), nil
+	}
+
+	var sums []byte
+	parts := 0
+	for {
+		hash := md5.New()
+		n, err := io.CopyN(hash, body, partSize)
+		if n > 0 {
+			sums = append(sums, hash.Sum(nil)...)
+			parts++
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	sum := md5.Sum(sums)
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), parts), nil
+}
+
 func getCosEndpoint(bucketLocation string, endpointType string) string {
 	if bucketLocation != "" {
 		switch endpointType {
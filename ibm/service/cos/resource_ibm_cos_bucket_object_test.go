@@ -4,9 +4,12 @@
 package cos_test
 
 import (
+	"crypto/rand"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+	"path/filepath"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -68,6 +71,72 @@ func TestAccIBMCOSBucketObject_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCOSBucketObject_multipart(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	objectFile := filepath.Join(t.TempDir(), "multipart.bin")
+	writeRandomFile := func(size int) {
+		content := make([]byte, size)
+		if _, err := rand.Read(content); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(objectFile, content, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeRandomFile(12 * 1024 * 1024)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, objectFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "content_length", "12582912"),
+					resource.TestMatchResourceAttr("ibm_cos_bucket_object.testacc", "etag", regexp.MustCompile(`^[0-9a-f]{32}-3$`)),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "server_side_encryption", "AES256"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "metadata.source", "acceptance-test"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "object_tags.env", "test"),
+				),
+			},
+			{
+				// Rewriting the file in place must be detected through the multipart ETag.
+				PreConfig: func() { writeRandomFile(11 * 1024 * 1024) },
+				Config:    testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, objectFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "content_length", "11534336"),
+					resource.TestMatchResourceAttr("ibm_cos_bucket_object.testacc", "etag", regexp.MustCompile(`^[0-9a-f]{32}-3$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMCOSBucketObject_legalHold(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectConfig_legalHold(name, instanceCRN, "ON"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "object_lock_legal_hold_status", "ON"),
+				),
+			},
+			{
+				// The hold is released so that the object can be deleted.
+				Config: testAccIBMCOSBucketObjectConfig_legalHold(name, instanceCRN, "OFF"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "object_lock_legal_hold_status", "OFF"),
+				),
+			},
+		},
+	})
+}
+
 func testAccIBMCOSBucketObjectConfig_plaintext(name string, instanceCRN string, objectBody string) string {
 	return fmt.Sprintf(`
 		resource "ibm_cos_bucket" "testacc" {
@@ -115,3 +184,51 @@ func testAccIBMCOSBucketObjectConfig_file(name string, instanceCRN string, objec
 			content_file	  = "%[3]s"
 		}`, name, instanceCRN, objectFile)
 }
+
+func testAccIBMCOSBucketObjectConfig_multipart(name string, instanceCRN string, objectFile string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn             = ibm_cos_bucket.testacc.crn
+			bucket_location        = ibm_cos_bucket.testacc.region_location
+			key                    = "%[1]s.bin"
+			content_file           = "%[3]s"
+			part_size              = 5
+			upload_concurrency     = 2
+			server_side_encryption = "AES256"
+			metadata = {
+				source = "acceptance-test"
+			}
+			object_tags = {
+				env = "test"
+			}
+		}`, name, instanceCRN, objectFile)
+}
+
+func testAccIBMCOSBucketObjectConfig_legalHold(name string, instanceCRN string, legalHold string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+			object_versioning {
+				enable = true
+			}
+			object_lock_configuration {
+				object_lock_enabled = "Enabled"
+			}
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn                    = ibm_cos_bucket.testacc.crn
+			bucket_location               = ibm_cos_bucket.testacc.region_location
+			key                           = "%[1]s.txt"
+			content                       = "records"
+			object_lock_legal_hold_status = "%[3]s"
+		}`, name, instanceCRN, legalHold)
+}
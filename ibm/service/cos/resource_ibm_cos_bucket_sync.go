@@ -0,0 +1,552 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cosDeleteObjectsBatchSize is the maximum number of keys accepted by a
+// single multi-object delete request.
+const cosDeleteObjectsBatchSize = 1000
+
+// cosSyncFile is a local file that is synchronized to a bucket key.
+type cosSyncFile struct {
+	path string
+	etag string
+}
+
+func ResourceIBMCOSBucketSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketSyncCreate,
+		ReadContext:   resourceIBMCOSBucketSyncRead,
+		UpdateContext: resourceIBMCOSBucketSyncUpdate,
+		DeleteContext: resourceIBMCOSBucketSyncDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMCOSBucketSyncImport,
+		},
+
+		CustomizeDiff: resourceIBMCOSBucketSyncCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Local directory whose files are synchronized to the bucket",
+			},
+			"key_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Prefix prepended to the relative path of each file to build its object key. A slash is added when the prefix does not end with one.",
+			},
+			"exclude_patterns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns matched against the slash separated relative path of each file, ** matches any number of directories. Patterns without a slash are matched against the file name. Matching files are not synchronized.",
+			},
+			"content_types": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Content types by file extension, such as .wasm, that override the content type detected from the extension",
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      16,
+				ValidateFunc: validation.IntBetween(5, 5120),
+				Description:  "Size in MiB of each part when a file is uploaded in multiple parts",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3manager.DefaultUploadConcurrency,
+				ValidateFunc: validation.IntBetween(1, 32),
+				Description:  "Number of files uploaded in parallel",
+			},
+			"objects": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "ETags of the managed objects by object key",
+			},
+		},
+	}
+}
+
+func resourceIBMCOSBucketSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	// The ID is set before the upload so that the objects uploaded before a
+	// failure are tracked in state and deleted with the resource
+	d.SetId(fmt.Sprintf("%s:sync:%s:location:%s", bucketCRN, keyPrefix, bucketLocation))
+
+	objects, err := syncCOSBucketObjects(ctx, d, m)
+	d.Set("objects", objects)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMCOSBucketSyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketSyncImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	syncIndex := strings.Index(id, ":sync:")
+	locationIndex := strings.LastIndex(id, ":location:")
+	if syncIndex < 0 || locationIndex < syncIndex {
+		return nil, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be bucketCRN:sync:keyPrefix:location:bucketLocation", id)
+	}
+	d.Set("bucket_crn", id[:syncIndex])
+	d.Set("key_prefix", id[syncIndex+len(":sync:"):locationIndex])
+	d.Set("bucket_location", id[locationIndex+len(":location:"):])
+	d.Set("endpoint_type", "public")
+
+	s3Client, bucketName, err := getCOSBucketSyncClient(d, m)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every object under the prefix is adopted, the next apply deletes the
+	// objects without a file in source_dir
+	remote, err := listCOSObjectETags(s3Client, bucketName, cosSyncKeyPrefix(d))
+	if err != nil {
+		return nil, err
+	}
+	d.Set("objects", remote)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceIBMCOSBucketSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := getCOSBucketSyncClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remote, err := listCOSObjectETags(s3Client, bucketName, cosSyncKeyPrefix(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only keys created by this resource are reported, objects written to
+	// the prefix by anything else are left alone.
+	objects := make(map[string]string)
+	for key := range d.Get("objects").(map[string]interface{}) {
+		if etag, ok := remote[key]; ok {
+			objects[key] = etag
+		}
+	}
+	d.Set("objects", objects)
+
+	return nil
+}
+
+func resourceIBMCOSBucketSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("objects", "source_dir", "exclude_patterns", "content_types") {
+		objects, err := syncCOSBucketObjects(ctx, d, m)
+		d.Set("objects", objects)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMCOSBucketSyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := getCOSBucketSyncClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keys := make([]string, 0)
+	for key := range d.Get("objects").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	if err := deleteCOSObjects(s3Client, bucketName, keys); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMCOSBucketSyncCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"source_dir", "key_prefix", "exclude_patterns", "part_size"} {
+		if !diff.NewValueKnown(key) {
+			return diff.SetNewComputed("objects")
+		}
+	}
+
+	files, err := readCOSSyncFiles(diff)
+	if err != nil {
+		return err
+	}
+
+	objects := make(map[string]interface{}, len(files))
+	for key, file := range files {
+		objects[key] = file.etag
+	}
+	if !reflect.DeepEqual(objects, diff.Get("objects").(map[string]interface{})) {
+		return diff.SetNew("objects", objects)
+	}
+	return nil
+}
+
+func getCOSBucketSyncClient(d *schema.ResourceData, m interface{}) (*s3.S3, string, error) {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, "", err
+	}
+
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return nil, "", err
+	}
+	return s3Client, bucketName, nil
+}
+
+// syncCOSBucketObjects uploads the local files whose ETag differs from the
+// object in the bucket and deletes the previously managed keys that no longer
+// have a local file. It returns the ETags of the managed objects, which only
+// include the objects uploaded before a failure.
+func syncCOSBucketObjects(ctx context.Context, d *schema.ResourceData, m interface{}) (map[string]string, error) {
+	oldObjects, _ := d.GetChange("objects")
+	objects := make(map[string]string)
+	for key, etag := range oldObjects.(map[string]interface{}) {
+		objects[key] = etag.(string)
+	}
+
+	s3Client, bucketName, err := getCOSBucketSyncClient(d, m)
+	if err != nil {
+		return objects, err
+	}
+
+	files, err := readCOSSyncFiles(d)
+	if err != nil {
+		return objects, err
+	}
+
+	keyPrefix := cosSyncKeyPrefix(d)
+	remote, err := listCOSObjectETags(s3Client, bucketName, keyPrefix)
+	if err != nil {
+		return objects, err
+	}
+
+	// Content types are only sent with an upload, so files whose content type
+	// changes are uploaded again even when their content is unchanged.
+	oldContentTypes, newContentTypes := d.GetChange("content_types")
+	uploads := make([]string, 0)
+	for key, file := range files {
+		if remote[key] != file.etag ||
+			cosSyncContentType(file.path, oldContentTypes.(map[string]interface{})) != cosSyncContentType(file.path, newContentTypes.(map[string]interface{})) {
+			uploads = append(uploads, key)
+			continue
+		}
+		objects[key] = file.etag
+	}
+	sort.Strings(uploads)
+
+	removed := make([]string, 0)
+	for key := range oldObjects.(map[string]interface{}) {
+		if _, ok := files[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+
+	log.Printf("[INFO] Synchronizing COS bucket (%s) prefix (%s): %d to upload, %d to delete, %d unchanged",
+		bucketName, keyPrefix, len(uploads), len(removed), len(files)-len(uploads))
+
+	contentTypes := newContentTypes.(map[string]interface{})
+	uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+		u.PartSize = int64(d.Get("part_size").(int)) * cosObjectPartSizeUnit
+	})
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		errs    []string
+		pending = make(chan string)
+	)
+	for i := 0; i < d.Get("upload_concurrency").(int); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range pending {
+				err := uploadCOSSyncFile(ctx, uploader, bucketName, key, files[key].path, contentTypes)
+				mu.Lock()
+				if err != nil {
+					errs = append(errs, err.Error())
+				} else {
+					objects[key] = files[key].etag
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, key := range uploads {
+		pending <- key
+	}
+	close(pending)
+	wg.Wait()
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return objects, fmt.Errorf("[ERROR] Error uploading %d of %d files to COS bucket (%s):\n%s", len(errs), len(uploads), bucketName, strings.Join(errs, "\n"))
+	}
+
+	if err := deleteCOSObjects(s3Client, bucketName, removed); err != nil {
+		return objects, err
+	}
+	for _, key := range removed {
+		delete(objects, key)
+	}
+	return objects, nil
+}
+
+// cosSyncKeyPrefix returns key_prefix with a trailing slash, so that the
+// relative path of a file is a key below the prefix
+func cosSyncKeyPrefix(d cosObjectGetter) string {
+	keyPrefix := d.Get("key_prefix").(string)
+	if keyPrefix != "" && !strings.HasSuffix(keyPrefix, "/") {
+		keyPrefix += "/"
+	}
+	return keyPrefix
+}
+
+// matchCOSSyncPattern matches a slash separated relative path against an
+// exclude pattern. A ** segment matches any number of directories, and a
+// pattern without a slash is matched against the file name.
+func matchCOSSyncPattern(pattern, rel string) (bool, error) {
+	if !strings.Contains(pattern, "/") {
+		return path.Match(pattern, path.Base(rel))
+	}
+	return matchCOSSyncSegments(strings.Split(pattern, "/"), strings.Split(rel, "/"))
+}
+
+func matchCOSSyncSegments(pattern, segments []string) (bool, error) {
+	if len(pattern) == 0 {
+		return len(segments) == 0, nil
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			matched, err := matchCOSSyncSegments(pattern[1:], segments[i:])
+			if err != nil || matched {
+				return matched, err
+			}
+		}
+		return false, nil
+	}
+	if len(segments) == 0 {
+		return false, nil
+	}
+	matched, err := path.Match(pattern[0], segments[0])
+	if err != nil || !matched {
+		return false, err
+	}
+	return matchCOSSyncSegments(pattern[1:], segments[1:])
+}
+
+func uploadCOSSyncFile(ctx context.Context, uploader *s3manager.Uploader, bucketName, key, path string, contentTypes map[string]interface{}) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening %s: %s", path, err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
+		}
+	}()
+
+	_, err = uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:      aws.String(bucketName),
+		Key:         aws.String(key),
+		Body:        file,
+		ContentType: aws.String(cosSyncContentType(path, contentTypes)),
+	})
+	if err != nil {
+		return fmt.Errorf("uploading %s to %s: %s", path, key, err)
+	}
+	return nil
+}
+
+// readCOSSyncFiles walks source_dir and returns the files to synchronize with
+// their ETags, by object key.
+func readCOSSyncFiles(d cosObjectGetter) (map[string]cosSyncFile, error) {
+	sourceDir := d.Get("source_dir").(string)
+	keyPrefix := cosSyncKeyPrefix(d)
+	partSize := int64(d.Get("part_size").(int)) * cosObjectPartSizeUnit
+
+	excludes := make([]string, 0)
+	for _, pattern := range d.Get("exclude_patterns").([]interface{}) {
+		if pattern != nil {
+			excludes = append(excludes, pattern.(string))
+		}
+	}
+
+	files := make(map[string]cosSyncFile)
+	err := filepath.WalkDir(sourceDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		for _, pattern := range excludes {
+			matched, err := matchCOSSyncPattern(pattern, rel)
+			if err != nil {
+				return fmt.Errorf("invalid exclude pattern %q: %s", pattern, err)
+			}
+			if matched {
+				return nil
+			}
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		etag, err := cosObjectETag(file, partSize)
+		if err != nil {
+			return fmt.Errorf("hashing %s: %s", path, err)
+		}
+		files[keyPrefix+rel] = cosSyncFile{path: path, etag: etag}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading source directory (%s): %s", sourceDir, err)
+	}
+	return files, nil
+}
+
+func cosSyncContentType(path string, contentTypes map[string]interface{}) string {
+	ext := strings.ToLower(filepath.Ext(path))
+	if v, ok := contentTypes[ext]; ok {
+		return v.(string)
+	}
+	if contentType := mime.TypeByExtension(ext); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
+
+func listCOSObjectETags(s3Client *s3.S3, bucketName, keyPrefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+	}
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+
+	etags := make(map[string]string)
+	err := s3Client.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			etags[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing objects in COS bucket (%s) with prefix (%s): %s", bucketName, keyPrefix, err)
+	}
+	return etags, nil
+}
+
+func deleteCOSObjects(s3Client *s3.S3, bucketName string, keys []string) error {
+	for start := 0; start < len(keys); start += cosDeleteObjectsBatchSize {
+		end := start + cosDeleteObjectsBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		objects := make([]*s3.ObjectIdentifier, 0, end-start)
+		for _, key := range keys[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+		out, err := s3Client.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucketName),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error deleting objects from COS bucket (%s): %s", bucketName, err)
+		}
+		if len(out.Errors) > 0 {
+			msgs := make([]string, 0, len(out.Errors))
+			for _, e := range out.Errors {
+				msgs = append(msgs, fmt.Sprintf("%s: %s", aws.StringValue(e.Key), aws.StringValue(e.Message)))
+			}
+			return fmt.Errorf("[ERROR] Error deleting %d objects from COS bucket (%s):\n%s", len(out.Errors), bucketName, strings.Join(msgs, "\n"))
+		}
+	}
+	return nil
+}
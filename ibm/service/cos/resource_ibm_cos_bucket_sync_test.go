@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketSync_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	sourceDir := t.TempDir()
+	writeFile := func(rel, content string) {
+		path := filepath.Join(sourceDir, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("index.html", "<html><body>index</body></html>")
+	writeFile("css/site.css", "body { margin: 0; }")
+	writeFile("notes.tmp", "not synchronized")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketSyncConfig(name, instanceCRN, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_sync.testacc", "objects.%", "2"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_sync.testacc", "objects.site/index.html"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_sync.testacc", "objects.site/css/site.css"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeFile("index.html", "<html><body>updated</body></html>")
+					writeFile("js/app.js", "console.log('sync')")
+					if err := os.Remove(filepath.Join(sourceDir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccIBMCOSBucketSyncConfig(name, instanceCRN, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_sync.testacc", "objects.%", "2"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_sync.testacc", "objects.site/index.html"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_sync.testacc", "objects.site/js/app.js"),
+					resource.TestCheckNoResourceAttr("ibm_cos_bucket_sync.testacc", "objects.site/css/site.css"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cos_bucket_sync.testacc",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"source_dir", "exclude_patterns", "content_types", "part_size", "upload_concurrency"},
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketSyncConfig(name string, instanceCRN string, sourceDir string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+			force_delete         = true
+		}
+		resource "ibm_cos_bucket_sync" "testacc" {
+			bucket_crn       = ibm_cos_bucket.testacc.crn
+			bucket_location  = ibm_cos_bucket.testacc.region_location
+			source_dir       = "%[3]s"
+			key_prefix       = "site/"
+			exclude_patterns = ["**/*.tmp"]
+			content_types = {
+				".js" = "application/javascript"
+			}
+		}`, name, instanceCRN, sourceDir)
+}
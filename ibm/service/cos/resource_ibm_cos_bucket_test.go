@@ -576,6 +576,74 @@ func TestAccIBMCosBucket_Object_Versioning(t *testing.T) {
 	})
 }
 
+func TestAccIBMCosBucket_Website_Cors_PublicAccess(t *testing.T) {
+
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-east"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_website(cosServiceName, bucketName, bucketRegion, bucketClass),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "website_configuration.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "website_configuration.0.index_document", "index.html"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "website_configuration.0.error_document", "404.html"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "website_configuration.0.routing_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "public_access", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_website_removed(cosServiceName, bucketName, bucketRegion, bucketClass),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "website_configuration.#", "0"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "cors_rule.#", "0"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "public_access", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMCosBucket_Object_Lock(t *testing.T) {
+
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-east"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMCosBucket_object_lock(cosServiceName, bucketName, bucketRegion, bucketClass, false),
+				ExpectError: regexp.MustCompile("requires object_versioning to be enabled"),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_object_lock(cosServiceName, bucketName, bucketRegion, bucketClass, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_versioning.0.enable", "true"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock_configuration.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock_configuration.0.object_lock_enabled", "Enabled"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCosBucket_Hard_Quota(t *testing.T) {
 
 	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
@@ -1748,6 +1816,101 @@ func testAccCheckIBMCosBucket_object_versioning(cosServiceName string, bucketNam
 	`, cosServiceName, bucketName, region, storageClass)
 }
 
+func testAccCheckIBMCosBucket_website(cosServiceName string, bucketName string, region string, storageClass string) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%s"
+		storage_class        = "%s"
+		public_access        = true
+		website_configuration {
+			index_document = "index.html"
+			error_document = "404.html"
+			routing_rule {
+				condition {
+					key_prefix_equals = "docs/"
+				}
+				redirect {
+					replace_key_prefix_with = "documents/"
+				}
+			}
+		}
+		cors_rule {
+			allowed_methods = ["GET", "HEAD"]
+			allowed_origins = ["https://example.com"]
+			allowed_headers = ["*"]
+			max_age_seconds = 3000
+		}
+	}
+	`, cosServiceName, bucketName, region, storageClass)
+}
+
+func testAccCheckIBMCosBucket_website_removed(cosServiceName string, bucketName string, region string, storageClass string) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%s"
+		storage_class        = "%s"
+		public_access        = false
+	}
+	`, cosServiceName, bucketName, region, storageClass)
+}
+
+func testAccCheckIBMCosBucket_object_lock(cosServiceName string, bucketName string, region string, storageClass string, versioning bool) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%s"
+		storage_class        = "%s"
+		object_versioning {
+			enable = %t
+		}
+		object_lock_configuration {
+			object_lock_enabled = "Enabled"
+		}
+	}
+	`, cosServiceName, bucketName, region, storageClass, versioning)
+}
+
 func testAccCheckIBMCosBucket_hard_quota(cosServiceName string, bucketName string, regiontype string, region string, storageClass string, hardQuota int) string {
 
 	return fmt.Sprintf(`
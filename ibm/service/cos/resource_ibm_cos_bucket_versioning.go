@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMCOSBucketVersioning() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketVersioningCreate,
+		Read:     resourceIBMCOSBucketVersioningRead,
+		Update:   resourceIBMCOSBucketVersioningUpdate,
+		Delete:   resourceIBMCOSBucketVersioningDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"versioning_configuration": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Versioning configuration of the bucket",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"Enabled", "Suspended"}),
+							Description:  "Versioning state of the bucket: Enabled or Suspended",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func putCOSBucketVersioning(s3Client *s3.S3, bucketName, status string) error {
+	input := &s3.PutBucketVersioningInput{
+		Bucket: aws.String(bucketName),
+		VersioningConfiguration: &s3.VersioningConfiguration{
+			Status: aws.String(status),
+		},
+	}
+	_, err := s3Client.PutBucketVersioning(input)
+	return err
+}
+
+func resourceIBMCOSBucketVersioningCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	status := d.Get("versioning_configuration.0.status").(string)
+	if err = putCOSBucketVersioning(s3Client, bucketName, status); err != nil {
+		return fmt.Errorf("failed to create the object versioning on COS bucket %s, %v", bucketName, err)
+	}
+
+	//Generating a fake id which contains every information about to get the bucket via s3 api
+	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+	d.SetId(bktID)
+
+	return resourceIBMCOSBucketVersioningRead(d, meta)
+}
+
+func resourceIBMCOSBucketVersioningUpdate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("versioning_configuration") {
+		status := d.Get("versioning_configuration.0.status").(string)
+		if err = putCOSBucketVersioning(s3Client, bucketName, status); err != nil {
+			return fmt.Errorf("failed to update the object versioning on COS bucket %s, %v", bucketName, err)
+		}
+	}
+	return resourceIBMCOSBucketVersioningRead(d, meta)
+}
+
+func resourceIBMCOSBucketVersioningRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseBucketReplId(d.Id(), "bucketCRN")
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	versionInput := &s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucketName),
+	}
+	versionPtr, err := s3Client.GetBucketVersioning(versionInput)
+	if err != nil {
+		return fmt.Errorf("failed to read the object versioning of COS bucket %s, %v", bucketName, err)
+	}
+
+	// a bucket on which versioning was never enabled reports no status
+	status := "Suspended"
+	if versionPtr.Status != nil {
+		status = *versionPtr.Status
+	}
+	d.Set("versioning_configuration", []interface{}{
+		map[string]interface{}{
+			"status": status,
+		},
+	})
+	return nil
+}
+
+func resourceIBMCOSBucketVersioningDelete(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	// Versioning cannot be suspended on a bucket with object lock, the resource is only removed from state
+	objectLock, err := s3Client.GetObjectLockConfiguration(&s3.GetObjectLockConfigurationInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err == nil && objectLock.ObjectLockConfiguration != nil && aws.StringValue(objectLock.ObjectLockConfiguration.ObjectLockEnabled) == "Enabled" {
+		log.Printf("[INFO] Object lock is enabled on COS bucket %s, the object versioning stays enabled", bucketName)
+		return nil
+	}
+
+	// Versioning cannot be turned off once enabled, it can only be suspended
+	if err = putCOSBucketVersioning(s3Client, bucketName, "Suspended"); err != nil {
+		return fmt.Errorf("failed to suspend the object versioning on COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
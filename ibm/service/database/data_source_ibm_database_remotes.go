@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 )
 
 func DataSourceIBMDatabaseRemotes() *schema.Resource {
@@ -38,6 +40,35 @@ func DataSourceIBMDatabaseRemotes() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"replica_details": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Details of the replicas, if applicable.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Replica ID.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the replica deployment.",
+						},
+						"location": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region of the replica deployment.",
+						},
+						"state": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the replica deployment.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -70,6 +101,32 @@ func dataSourceIBMDatabaseRemotesRead(context context.Context, d *schema.Resourc
 		if err = d.Set("replicas", remotes.Remotes.Replicas); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting replicas: %s", err))
 		}
+
+		rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		replicaDetails := make([]map[string]interface{}, 0, len(remotes.Remotes.Replicas))
+		for _, replicaID := range remotes.Remotes.Replicas {
+			id := replicaID
+			instance, response, err := rsConClient.GetResourceInstanceWithContext(context, &rc.GetResourceInstanceOptions{
+				ID: &id,
+			})
+			if err != nil {
+				// the replica may be deleted or not visible to the caller, it is still listed in replicas
+				log.Printf("[WARN] GetResourceInstanceWithContext failed for replica %s, skipping its details %s\n%s", id, err, response)
+				continue
+			}
+			replicaDetails = append(replicaDetails, map[string]interface{}{
+				"id":       id,
+				"name":     core.StringNilMapper(instance.Name),
+				"location": core.StringNilMapper(instance.RegionID),
+				"state":    core.StringNilMapper(instance.State),
+			})
+		}
+		if err = d.Set("replica_details", replicaDetails); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting replica_details: %s", err))
+		}
 	}
 
 	return nil
@@ -28,6 +28,8 @@ func TestAccIBMDatabaseRemotesDataSourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.ibm_database_remotes.database_remotes", "leader", ""),
 					resource.TestCheckResourceAttrSet("data.ibm_database_remotes.database_remotes_replica", "leader"),
 					resource.TestCheckResourceAttrSet("data.ibm_database_remotes.database_remotes", "replicas.#"),
+					resource.TestCheckResourceAttr("data.ibm_database_remotes.database_remotes", "replica_details.0.name", testName+"-replica"),
+					resource.TestCheckResourceAttr("data.ibm_database_remotes.database_remotes", "replica_details.0.state", "active"),
 				),
 			},
 		},
@@ -50,6 +52,7 @@ func testAccCheckIBMDatabaseDataSourceConfig4(name string) string {
 		service           = "databases-for-postgresql"
 		plan              = "standard"
 		location          = "%[2]s"
+		adminpassword     = "password12"
 		tags              = ["one:two"]
 	}
 
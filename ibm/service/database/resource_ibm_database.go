@@ -8,11 +8,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"math"
 	"net/url"
 	"os"
 	"reflect"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -167,11 +169,12 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Computed:    true,
 			},
 			"adminpassword": {
-				Description:  "The admin user password for the instance",
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringLenBetween(10, 32),
-				Sensitive:    true,
+				Description:      "The admin user password for the instance. The password is not stored in state, only its hash.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringLenBetween(10, 32),
+				Sensitive:        true,
+				DiffSuppressFunc: flex.SuppressSaltedHashedSecret,
 				// DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 				//  return true
 				// },
@@ -193,6 +196,12 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Computed:    true,
 				Description: "The configuration schema in JSON format",
 			},
+			"configuration_requires_restart": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The configuration settings of the last change that require a database restart",
+			},
 			"version": {
 				Description: "The database version to provision if specified",
 				Type:        schema.TypeString,
@@ -324,8 +333,10 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				DiffSuppressFunc: flex.ApplyOnce,
 			},
 			"users": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:       schema.TypeSet,
+				Optional:   true,
+				Deprecated: "users is deprecated, use the ibm_database_user resource instead",
+				Set:        resourceIBMDatabaseUserHash,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -335,11 +346,12 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 							ValidateFunc: validation.StringLenBetween(5, 32),
 						},
 						"password": {
-							Description:  "User password",
-							Type:         schema.TypeString,
-							Required:     true,
-							Sensitive:    true,
-							ValidateFunc: validation.StringLenBetween(10, 32),
+							Description:      "User password. The password is not stored in state, only its hash.",
+							Type:             schema.TypeString,
+							Required:         true,
+							Sensitive:        true,
+							ValidateFunc:     validation.StringLenBetween(10, 32),
+							DiffSuppressFunc: flex.SuppressSaltedHashedSecret,
 						},
 						"type": {
 							Description:  "User type",
@@ -441,8 +453,9 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				},
 			},
 			"whitelist": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:       schema.TypeSet,
+				Optional:   true,
+				Deprecated: "whitelist is deprecated, use the ibm_database_allowlist_entry resource instead",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"address": {
@@ -1090,12 +1103,212 @@ func checkMbValue(name string, limits MbLimit, divider int, diff *schema.Resourc
 	return checkGroupValue(name, groupLimit, divider, diff)
 }
 
+type ConfigurationSetting struct {
+	Type            string        `json:"type"`
+	Min             *float64      `json:"min,omitempty"`
+	Max             *float64      `json:"max,omitempty"`
+	Choices         []interface{} `json:"choices,omitempty"`
+	RequiresRestart bool          `json:"requires_restart"`
+}
+
+type ConfigurationSchema struct {
+	Schema map[string]ConfigurationSetting `json:"schema"`
+}
+
+func checkConfigurationValue(name string, value interface{}, setting ConfigurationSetting) error {
+	switch setting.Type {
+	case "integer", "float", "number":
+		number, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("[ERROR] configuration %s must be a number, got %v", name, value)
+		}
+		if setting.Type == "integer" && number != math.Trunc(number) {
+			return fmt.Errorf("[ERROR] configuration %s must be an integer, got %v", name, value)
+		}
+		if (setting.Min != nil && number < *setting.Min) || (setting.Max != nil && number > *setting.Max) {
+			min, max := "-", "-"
+			if setting.Min != nil {
+				min = strconv.FormatFloat(*setting.Min, 'f', -1, 64)
+			}
+			if setting.Max != nil {
+				max = strconv.FormatFloat(*setting.Max, 'f', -1, 64)
+			}
+			return fmt.Errorf("[ERROR] configuration %s must be >= %s and <= %s, got %v", name, min, max, value)
+		}
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("[ERROR] configuration %s must be a string, got %v", name, value)
+		}
+		if len(setting.Choices) > 0 {
+			for _, choice := range setting.Choices {
+				if fmt.Sprint(choice) == str {
+					return nil
+				}
+			}
+			return fmt.Errorf("[ERROR] configuration %s must be one of %v, got %s", name, setting.Choices, str)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("[ERROR] configuration %s must be a boolean, got %v", name, value)
+		}
+	}
+	return nil
+}
+
+func configurationSetting(settingType string, min, max float64, requiresRestart bool) ConfigurationSetting {
+	return ConfigurationSetting{Type: settingType, Min: &min, Max: &max, RequiresRestart: requiresRestart}
+}
+
+func configurationChoices(requiresRestart bool, choices ...interface{}) ConfigurationSetting {
+	return ConfigurationSetting{Type: "string", Choices: choices, RequiresRestart: requiresRestart}
+}
+
+// postgresqlConfigurationSchema holds the configuration settings of the PostgreSQL and EnterpriseDB deployments
+var postgresqlConfigurationSchema = map[string]ConfigurationSetting{
+	"archive_timeout":            configurationSetting("integer", 300, 1073741823, false),
+	"deadlock_timeout":           configurationSetting("integer", 100, 2147483647, false),
+	"effective_io_concurrency":   configurationSetting("integer", 1, 1000, false),
+	"log_connections":            configurationChoices(false, "off", "on"),
+	"log_disconnections":         configurationChoices(false, "off", "on"),
+	"log_min_duration_statement": configurationSetting("integer", 100, 2147483647, false),
+	"max_connections":            configurationSetting("integer", 115, 5000, true),
+	"max_prepared_transactions":  configurationSetting("integer", 0, 262143, true),
+	"max_replication_slots":      configurationSetting("integer", 10, 262143, true),
+	"max_wal_senders":            configurationSetting("integer", 12, 262143, true),
+	"synchronous_commit":         configurationChoices(false, "local", "off"),
+	"tcp_keepalives_count":       configurationSetting("integer", 0, 2147483647, false),
+	"tcp_keepalives_idle":        configurationSetting("integer", 0, 2147483647, false),
+	"tcp_keepalives_interval":    configurationSetting("integer", 0, 2147483647, false),
+	"wal_level":                  configurationChoices(true, "hot_standby", "logical"),
+}
+
+// newDeploymentConfigurationSchemas holds, by service, the configuration settings every deployment
+// of the service has. The configuration schema of a deployment can only be read once it exists.
+var newDeploymentConfigurationSchemas = map[string]map[string]ConfigurationSetting{
+	"databases-for-postgresql":   postgresqlConfigurationSchema,
+	"databases-for-enterprisedb": postgresqlConfigurationSchema,
+	"databases-for-redis": {
+		"appendonly":                  configurationChoices(false, "yes", "no"),
+		"maxmemory-policy":            configurationChoices(false, "volatile-lru", "allkeys-lru", "volatile-lfu", "allkeys-lfu", "volatile-random", "allkeys-random", "volatile-ttl", "noeviction"),
+		"stop-writes-on-bgsave-error": configurationChoices(false, "yes", "no"),
+	},
+}
+
+// checkNewDeploymentConfiguration validates the configuration of a new deployment against the settings
+// every deployment of the service has, so that an invalid value fails the plan instead of the provisioning.
+// Other settings are checked by the deployment.
+func checkNewDeploymentConfiguration(service string, configuration map[string]interface{}) error {
+	settings := newDeploymentConfigurationSchemas[service]
+	for name, value := range configuration {
+		setting, ok := settings[name]
+		if !ok {
+			log.Printf("[WARN] configuration %s of the new %s deployment can only be checked once the deployment exists", name, service)
+			continue
+		}
+		if err := checkConfigurationValue(name, value, setting); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkConfiguration validates the configuration against the configuration schema of the deployment
+// and records the changed settings that require a restart
+func checkConfiguration(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("configuration") {
+		// the restart list only describes the last configuration change, clear it with the next update
+		if len(diff.Get("configuration_requires_restart").([]interface{})) > 0 && len(diff.GetChangedKeysPrefix("")) > 0 {
+			return diff.SetNew("configuration_requires_restart", []string{})
+		}
+		return nil
+	}
+	if !diff.NewValueKnown("configuration") {
+		return nil
+	}
+	oldConfig, newConfig := diff.GetChange("configuration")
+	if newConfig.(string) == "" {
+		return nil
+	}
+
+	service := diff.Get("service").(string)
+	if service != "databases-for-postgresql" && service != "databases-for-redis" && service != "databases-for-enterprisedb" {
+		return fmt.Errorf("[ERROR] given database type %s is not configurable", service)
+	}
+
+	var configuration map[string]interface{}
+	if err := json.Unmarshal([]byte(newConfig.(string)), &configuration); err != nil {
+		return fmt.Errorf("[ERROR] configuration must be a JSON object: %s", err)
+	}
+
+	// The configuration schema is only available once the deployment exists, a new deployment
+	// is checked against the settings every deployment of the service has
+	if diff.Id() == "" {
+		return checkNewDeploymentConfiguration(service, configuration)
+	}
+	schemaJSON := diff.Get("configuration_schema").(string)
+	if schemaJSON == "" {
+		icdClient, err := meta.(conns.ClientSession).ICDAPI()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+		}
+		configSchema, err := icdClient.Configurations().GetConfiguration(diff.Id())
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error getting database (%s) configuration schema : %s", diff.Id(), err)
+		}
+		s, err := json.Marshal(configSchema)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error marshalling the database configuration schema: %s", err)
+		}
+		schemaJSON = string(s)
+	}
+
+	var configurationSchema ConfigurationSchema
+	if err := json.Unmarshal([]byte(schemaJSON), &configurationSchema); err != nil {
+		return fmt.Errorf("[ERROR] Error parsing the database configuration schema: %s", err)
+	}
+	if len(configurationSchema.Schema) == 0 {
+		return nil
+	}
+
+	var previous map[string]interface{}
+	if oldConfig.(string) != "" {
+		json.Unmarshal([]byte(oldConfig.(string)), &previous)
+	}
+
+	restart := make([]string, 0)
+	for name, value := range configuration {
+		setting, ok := configurationSchema.Schema[name]
+		if !ok {
+			keys := make([]string, 0, len(configurationSchema.Schema))
+			for k := range configurationSchema.Schema {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			return fmt.Errorf("[ERROR] %s is not a configuration setting of %s, supported settings are: %s", name, service, strings.Join(keys, ", "))
+		}
+		if err := checkConfigurationValue(name, value, setting); err != nil {
+			return err
+		}
+		if setting.RequiresRestart && !reflect.DeepEqual(previous[name], value) {
+			restart = append(restart, name)
+		}
+	}
+	sort.Strings(restart)
+	return diff.SetNew("configuration_requires_restart", restart)
+}
+
 func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
 	err = flex.ResourceTagsCustomizeDiff(diff)
 	if err != nil {
 		return err
 	}
 
+	err = checkConfiguration(diff, meta)
+	if err != nil {
+		return err
+	}
+
 	service := diff.Get("service").(string)
 	planPhase := diff.Get("plan_validation").(bool)
 
@@ -1710,11 +1923,15 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 	}
 	d.Set("auto_scaling", flattenICDAutoScalingGroup(autoSclaingGroup))
 
-	whitelist, err := icdClient.Whitelists().GetWhitelist(icdId)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database whitelist: %s", err))
+	// Without whitelist in the state, the allowlist entries are managed by ibm_database_allowlist_entry
+	// resources or outside of Terraform and are not read
+	if d.Get("whitelist").(*schema.Set).Len() > 0 {
+		whitelist, err := icdClient.Whitelists().GetWhitelist(icdId)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting database whitelist: %s", err))
+		}
+		d.Set("whitelist", flex.FlattenWhitelist(whitelist))
 	}
-	d.Set("whitelist", flex.FlattenWhitelist(whitelist))
 
 	var connectionStrings []flex.CsEntry
 	//ICD does not implement a GetUsers API. Users populated from tf configuration.
@@ -1734,6 +1951,14 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 	}
 	d.Set("connectionstrings", flex.FlattenConnectionStrings(connectionStrings))
 
+	// the passwords are write-only, only their hash is kept in state
+	if err = flex.SetHashedSecret(d, "adminpassword"); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = hashDatabaseUserPasswords(d); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting the database users: %s", err))
+	}
+
 	if serviceOff == "databases-for-postgresql" || serviceOff == "databases-for-redis" || serviceOff == "databases-for-enterprisedb" {
 		configSchema, err := icdClient.Configurations().GetConfiguration(icdId)
 		if err != nil {
@@ -2143,7 +2368,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 			// Update Database User password only
 			if change.Old != nil && change.New != nil {
 				// No change
-				if change.Old["password"].(string) == change.New["password"].(string) {
+				if flex.SecretMatches(change.Old["password"].(string), change.New["password"].(string)) {
 					continue
 				}
 
@@ -2910,3 +3135,28 @@ func checkV5Groups(_ context.Context, diff *schema.ResourceDiff, meta interface{
 
 	return nil
 }
+
+// resourceIBMDatabaseUserHash identifies a user without its password, which is compared to the hash in state
+func resourceIBMDatabaseUserHash(v interface{}) int {
+	user := v.(map[string]interface{})
+	return schema.HashString(fmt.Sprintf("%s-%s-%s", user["type"], user["role"], user["name"]))
+}
+
+// hashDatabaseUserPasswords replaces the passwords of the users in state with their hash
+func hashDatabaseUserPasswords(d *schema.ResourceData) error {
+	users := d.Get("users").(*schema.Set).List()
+	if len(users) == 0 {
+		return nil
+	}
+	for _, raw := range users {
+		user := raw.(map[string]interface{})
+		if password := user["password"].(string); password != "" && !flex.IsHashedSecret(password) {
+			hash, err := flex.HashSecret(password)
+			if err != nil {
+				return err
+			}
+			user["password"] = hash
+		}
+	}
+	return d.Set("users", users)
+}
@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const databaseAllowlistIDSeparator = "/allowlist/"
+
+func ResourceIBMDatabaseAllowlistEntry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseAllowlistEntryCreate,
+		ReadContext:   resourceIBMDatabaseAllowlistEntryRead,
+		DeleteContext: resourceIBMDatabaseAllowlistEntryDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Description: "ID of the database instance the allowlist entry belongs to",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"address": {
+				Description:  "Allowlist IP address in CIDR notation",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateCIDR,
+			},
+			"description": {
+				Description:  "Unique allowlist description",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+		},
+	}
+}
+
+func parseDatabaseAllowlistEntryID(id string) (deploymentID, address string, err error) {
+	parts := strings.SplitN(id, databaseAllowlistIDSeparator, 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of deploymentID%saddress", id, databaseAllowlistIDSeparator)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceIBMDatabaseAllowlistEntryCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+	address := d.Get("address").(string)
+
+	addAllowlistEntryOptions := &clouddatabasesv5.AddAllowlistEntryOptions{
+		ID: &deploymentID,
+		IPAddress: &clouddatabasesv5.AllowlistEntry{
+			Address:     core.StringPtr(address),
+			Description: core.StringPtr(d.Get("description").(string)),
+		},
+	}
+
+	addAllowlistEntryResponse, response, err := cloudDatabasesClient.AddAllowlistEntryWithContext(context, addAllowlistEntryOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] AddAllowlistEntry (%s) failed %s\n%s", address, err, response))
+	}
+
+	_, err = waitForDatabaseTaskComplete(*addAllowlistEntryResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) allowlist create task to complete for entry %s : %s", deploymentID, address, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", deploymentID, databaseAllowlistIDSeparator, address))
+
+	return resourceIBMDatabaseAllowlistEntryRead(context, d, meta)
+}
+
+func resourceIBMDatabaseAllowlistEntryRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	deploymentID, address, err := parseDatabaseAllowlistEntryID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	getAllowlistOptions := &clouddatabasesv5.GetAllowlistOptions{
+		ID: core.StringPtr(deploymentID),
+	}
+	allowlist, response, err := cloudDatabasesClient.GetAllowlistWithContext(context, getAllowlistOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Database instance %s not found, removing allowlist entry %s from state", deploymentID, address)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database allowlist: %s\n%s", err, response))
+	}
+
+	for _, entry := range allowlist.IPAddresses {
+		if entry.Address != nil && *entry.Address == address {
+			d.Set("deployment_id", deploymentID)
+			d.Set("address", address)
+			d.Set("description", entry.Description)
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] Allowlist entry %s not found on database %s, removing it from state", address, deploymentID)
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMDatabaseAllowlistEntryDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	deploymentID, address, err := parseDatabaseAllowlistEntryID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deleteAllowlistEntryOptions := &clouddatabasesv5.DeleteAllowlistEntryOptions{
+		ID:        &deploymentID,
+		Ipaddress: core.StringPtr(address),
+	}
+	deleteAllowlistEntryResponse, response, err := cloudDatabasesClient.DeleteAllowlistEntryWithContext(context, deleteAllowlistEntryOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] DeleteAllowlistEntry (%s) failed %s\n%s", address, err, response))
+	}
+
+	_, err = waitForDatabaseTaskComplete(*deleteAllowlistEntryResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) allowlist delete task to complete for ipAddress %s : %s", deploymentID, address, err))
+	}
+
+	d.SetId("")
+	return nil
+}
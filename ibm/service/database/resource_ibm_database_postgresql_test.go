@@ -361,6 +361,51 @@ func TestAccIBMDatabaseInstancePostgresImport(t *testing.T) {
 	})
 }
 
+func TestAccIBMDatabaseInstancePostgresConfiguration(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	serviceName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_database." + serviceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the configuration of a new deployment fails the plan instead of the provisioning
+				Config:      testAccCheckIBMDatabaseInstancePostgresConfiguration(databaseResourceGroup, serviceName, `{"max_connections": 100000}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("configuration max_connections must be >="),
+			},
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresImport(databaseResourceGroup, serviceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(resourceName, &databaseInstanceOne),
+					resource.TestCheckResourceAttrSet(resourceName, "configuration_schema"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMDatabaseInstancePostgresConfiguration(databaseResourceGroup, serviceName, `{"max_connections": 1}`),
+				ExpectError: regexp.MustCompile("configuration max_connections must be >="),
+			},
+			{
+				Config:      testAccCheckIBMDatabaseInstancePostgresConfiguration(databaseResourceGroup, serviceName, `{"max_connection": 200}`),
+				ExpectError: regexp.MustCompile("max_connection is not a configuration setting"),
+			},
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresConfiguration(databaseResourceGroup, serviceName, `{"max_connections": 200}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(resourceName, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(resourceName, "configuration_requires_restart.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_requires_restart.0", "max_connections"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMDatabaseInstanceDestroy(s *terraform.State) error {
 	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -860,3 +905,20 @@ func testAccCheckIBMDatabaseInstancePostgresImport(databaseResourceGroup string,
 	  }
 				`, databaseResourceGroup, name, acc.IcdDbRegion)
 }
+
+func testAccCheckIBMDatabaseInstancePostgresConfiguration(databaseResourceGroup string, name string, configuration string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+		configuration     = jsonencode(%[4]s)
+	  }
+				`, databaseResourceGroup, name, acc.IcdDbRegion, configuration)
+}
@@ -0,0 +1,346 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	_ "github.com/lib/pq"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// databaseReplicationLagQuery returns how many seconds the replay of a PostgreSQL replica is behind its
+// leader. A replica that replayed everything it received has no lag, even when the leader is idle.
+const databaseReplicationLagQuery = `SELECT CASE WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0) END`
+
+func ResourceIBMDatabaseReadReplica() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseReadReplicaCreate,
+		ReadContext:   resourceIBMDatabaseReadReplicaRead,
+		UpdateContext: resourceIBMDatabaseReadReplicaUpdate,
+		DeleteContext: resourceIBMDatabaseReadReplicaDelete,
+		CustomizeDiff: resourceIBMDatabaseReadReplicaValidate,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"replica_id": {
+				Description: "The CRN of the read-only replica deployment",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"promote": {
+				Description: "Promote the read-only replica to a standalone deployment. A promoted deployment can not become a replica again. With max_replication_lag, the promotion waits until the replica caught up with its leader.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"skip_initial_backup": {
+				Description: "Skip the initial backup taken after the promotion, to make the promoted deployment available sooner",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"max_replication_lag": {
+				Description:  "The replication lag, for example 30s, the replica must be below before it is promoted. The lag is read from the PostgreSQL replica with replication_lag_user, and the promotion fails when the lag stays above it for the create or update timeout.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDatabaseReplicationLag,
+				RequiredWith: []string{"replication_lag_password"},
+			},
+			"replication_lag_user": {
+				Description: "The database user that reads the replication lag of the replica",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "admin",
+			},
+			"replication_lag_password": {
+				Description:      "The password of replication_lag_user. The password is not stored in state, only its hash.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: flex.SuppressSaltedHashedSecret,
+			},
+			"leader_id": {
+				Description: "The CRN of the leader deployment the replica replicates from. Empty once the replica is promoted.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseReadReplicaValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && diff.HasChange("promote") {
+		if old, _ := diff.GetChange("promote"); old.(bool) {
+			return fmt.Errorf("[ERROR] The deployment %s was promoted and can not become a read-only replica again", diff.Id())
+		}
+	}
+	return nil
+}
+
+func validateDatabaseReplicationLag(v interface{}, k string) (ws []string, errors []error) {
+	lag, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration like 30s or 5m: %s", k, err))
+	} else if lag < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative", k))
+	}
+	return
+}
+
+// getDatabaseEndpointType returns the endpoint type the connections of the deployment use
+func getDatabaseEndpointType(context context.Context, cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, deploymentID string) (string, *core.DetailedResponse, error) {
+	getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: core.StringPtr(deploymentID),
+	}
+	getDeploymentInfoResponse, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, getDeploymentInfoOptions)
+	if err != nil {
+		return "", response, err
+	}
+	if deployment := getDeploymentInfoResponse.Deployment; deployment != nil && deployment.EnablePublicEndpoints != nil && !*deployment.EnablePublicEndpoints {
+		return clouddatabasesv5.GetConnectionOptionsEndpointTypePrivateConst, response, nil
+	}
+	return clouddatabasesv5.GetConnectionOptionsEndpointTypePublicConst, response, nil
+}
+
+// getDatabaseReplicationLag connects to the PostgreSQL replica as userName and returns how far its replay is
+// behind the leader
+func getDatabaseReplicationLag(context context.Context, cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, replicaID, userName, password string) (time.Duration, error) {
+	endpointType, response, err := getDatabaseEndpointType(context, cloudDatabasesClient, replicaID)
+	if err != nil {
+		return 0, fmt.Errorf("[ERROR] Error getting database (%s): %s\n%s", replicaID, err, response)
+	}
+	getConnectionOptions := &clouddatabasesv5.GetConnectionOptions{
+		ID:           core.StringPtr(replicaID),
+		UserType:     core.StringPtr("database"),
+		UserID:       core.StringPtr(userName),
+		EndpointType: core.StringPtr(endpointType),
+	}
+	connection, response, err := cloudDatabasesClient.GetConnectionWithContext(context, getConnectionOptions)
+	if err != nil {
+		return 0, fmt.Errorf("[ERROR] Error getting the connection of database (%s) user %s: %s\n%s", replicaID, userName, err, response)
+	}
+	conn, ok := connection.Connection.(*clouddatabasesv5.Connection)
+	if !ok || conn.Postgres == nil || len(conn.Postgres.Hosts) == 0 || conn.Postgres.Hosts[0].Hostname == nil || conn.Postgres.Hosts[0].Port == nil {
+		return 0, fmt.Errorf("[ERROR] The database %s has no PostgreSQL connection, max_replication_lag is only supported for PostgreSQL and EnterpriseDB replicas", replicaID)
+	}
+	postgres := conn.Postgres
+
+	query := url.Values{}
+	query.Set("sslmode", "require")
+	if postgres.Certificate != nil && postgres.Certificate.CertificateBase64 != nil {
+		certificate, err := base64.StdEncoding.DecodeString(*postgres.Certificate.CertificateBase64)
+		if err != nil {
+			return 0, fmt.Errorf("[ERROR] Error decoding the certificate of database (%s): %s", replicaID, err)
+		}
+		certFile, err := os.CreateTemp("", "ibm-database-*.crt")
+		if err != nil {
+			return 0, err
+		}
+		defer os.Remove(certFile.Name())
+		_, err = certFile.Write(certificate)
+		if closeErr := certFile.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return 0, fmt.Errorf("[ERROR] Error writing the certificate of database (%s): %s", replicaID, err)
+		}
+		query.Set("sslmode", "verify-full")
+		query.Set("sslrootcert", certFile.Name())
+	}
+	database := "ibmclouddb"
+	if postgres.Database != nil {
+		database = *postgres.Database
+	}
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(userName, password),
+		Host:     net.JoinHostPort(*postgres.Hosts[0].Hostname, strconv.FormatInt(*postgres.Hosts[0].Port, 10)),
+		Path:     "/" + database,
+		RawQuery: query.Encode(),
+	}
+
+	db, err := sql.Open("postgres", dsn.String())
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+	var lag float64
+	if err := db.QueryRowContext(context, databaseReplicationLagQuery).Scan(&lag); err != nil {
+		return 0, fmt.Errorf("[ERROR] Error reading the replication lag of database (%s): %s", replicaID, err)
+	}
+	return time.Duration(lag * float64(time.Second)), nil
+}
+
+// waitForDatabaseReplicationLag waits until the replication lag of the replica is below max_replication_lag
+func waitForDatabaseReplicationLag(context context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	v, ok := d.GetOk("max_replication_lag")
+	if !ok {
+		return nil
+	}
+	maxLag, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return err
+	}
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	// the state only holds the hash of an unchanged password
+	password := d.Get("replication_lag_password").(string)
+	if raw := d.GetRawConfig().GetAttr("replication_lag_password"); raw.IsKnown() && !raw.IsNull() {
+		password = raw.AsString()
+	}
+	replicaID := d.Get("replica_id").(string)
+	userName := d.Get("replication_lag_user").(string)
+
+	return resource.RetryContext(context, timeout, func() *resource.RetryError {
+		lag, err := getDatabaseReplicationLag(context, cloudDatabasesClient, replicaID, userName, password)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if lag > maxLag {
+			log.Printf("[INFO] The replication lag of database %s is %s, waiting for it to drop below %s", replicaID, lag, maxLag)
+			return resource.RetryableError(fmt.Errorf("[ERROR] The replication lag of database %s is %s, above max_replication_lag %s", replicaID, lag, maxLag))
+		}
+		return nil
+	})
+}
+
+func getDatabaseRemoteLeader(context context.Context, cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, deploymentID string) (string, *core.DetailedResponse, error) {
+	listRemotesOptions := &clouddatabasesv5.ListRemotesOptions{
+		ID: core.StringPtr(deploymentID),
+	}
+	remotes, response, err := cloudDatabasesClient.ListRemotesWithContext(context, listRemotesOptions)
+	if err != nil {
+		return "", response, err
+	}
+	if remotes.Remotes != nil && remotes.Remotes.Leader != nil {
+		return *remotes.Remotes.Leader, response, nil
+	}
+	return "", response, nil
+}
+
+func promoteDatabaseReadReplica(context context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	replicaID := d.Get("replica_id").(string)
+	start := time.Now()
+	if err := waitForDatabaseReplicationLag(context, d, meta, timeout); err != nil {
+		return err
+	}
+
+	promoteReadOnlyReplicaOptions := &clouddatabasesv5.PromoteReadOnlyReplicaOptions{
+		ID: core.StringPtr(replicaID),
+		Promotion: map[string]interface{}{
+			"skip_initial_backup": d.Get("skip_initial_backup").(bool),
+		},
+	}
+	promoteReadOnlyReplicaResponse, response, err := cloudDatabasesClient.PromoteReadOnlyReplicaWithContext(context, promoteReadOnlyReplicaOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] PromoteReadOnlyReplica (%s) failed %s\n%s", replicaID, err, response)
+	}
+
+	_, err = waitForDatabaseTaskComplete(*promoteReadOnlyReplicaResponse.Task.ID, d, meta, timeout-time.Since(start))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for database (%s) promotion task to complete: %s", replicaID, err)
+	}
+
+	return waitForICDReady(meta, replicaID)
+}
+
+func resourceIBMDatabaseReadReplicaCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	replicaID := d.Get("replica_id").(string)
+	leader, response, err := getDatabaseRemoteLeader(context, cloudDatabasesClient, replicaID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] ListRemotes (%s) failed %s\n%s", replicaID, err, response))
+	}
+	if leader == "" && !d.Get("promote").(bool) {
+		return diag.FromErr(fmt.Errorf("[ERROR] The deployment %s is not a read-only replica", replicaID))
+	}
+
+	d.SetId(replicaID)
+
+	if leader != "" && d.Get("promote").(bool) {
+		if err = promoteDatabaseReadReplica(context, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMDatabaseReadReplicaRead(context, d, meta)
+}
+
+func resourceIBMDatabaseReadReplicaRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	leader, response, err := getDatabaseRemoteLeader(context, cloudDatabasesClient, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Database deployment %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] ListRemotes (%s) failed %s\n%s", d.Id(), err, response))
+	}
+
+	d.Set("replica_id", d.Id())
+	d.Set("leader_id", leader)
+	d.Set("promote", leader == "")
+	if err = flex.SetHashedSecret(d, "replication_lag_password"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceIBMDatabaseReadReplicaUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("promote") && d.Get("promote").(bool) {
+		if err := promoteDatabaseReadReplica(context, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMDatabaseReadReplicaRead(context, d, meta)
+}
+
+func resourceIBMDatabaseReadReplicaDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The deployment is managed by ibm_database, only the resource is removed from the state
+	d.SetId("")
+	return nil
+}
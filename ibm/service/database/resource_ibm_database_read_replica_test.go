@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseReadReplica_promote(t *testing.T) {
+	testName := fmt.Sprintf("tf-Pgress-%s", acctest.RandString(16))
+	name := "ibm_database_read_replica.replica"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseReadReplicaConfig(testName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "leader_id", "ibm_database.db", "id"),
+					resource.TestCheckResourceAttr(name, "promote", "false"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseReadReplicaLagConfig(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "leader_id", ""),
+					resource.TestCheckResourceAttr(name, "promote", "true"),
+					resource.TestCheckResourceAttr(name, "max_replication_lag", "5m"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseReadReplicaConfig(name string, promote bool) string {
+	return testAccCheckIBMDatabaseDataSourceConfig4(name) + fmt.Sprintf(`
+		resource "ibm_database_read_replica" "replica" {
+			replica_id          = ibm_database.db_replica.id
+			promote             = %t
+			skip_initial_backup = true
+		}
+	`, promote)
+}
+
+func testAccCheckIBMDatabaseReadReplicaLagConfig(name string) string {
+	return testAccCheckIBMDatabaseDataSourceConfig4(name) + `
+		resource "ibm_database_read_replica" "replica" {
+			replica_id               = ibm_database.db_replica.id
+			promote                  = true
+			skip_initial_backup      = true
+			max_replication_lag      = "5m"
+			replication_lag_password = "password12"
+		}
+	`
+}
@@ -0,0 +1,305 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const databaseUserIDSeparator = "/users/"
+
+var (
+	databaseUserPasswordLetter  = regexp.MustCompile(`[A-Za-z]`)
+	databaseUserPasswordNumber  = regexp.MustCompile(`[0-9]`)
+	databaseUserPasswordChars   = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+	databaseUserPasswordSpecial = regexp.MustCompile(`[~!@#$%^&*()=+\[\]{}|;:,.<>/?_-]`)
+)
+
+func ResourceIBMDatabaseUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseUserCreate,
+		ReadContext:   resourceIBMDatabaseUserRead,
+		UpdateContext: resourceIBMDatabaseUserUpdate,
+		DeleteContext: resourceIBMDatabaseUserDelete,
+		CustomizeDiff: resourceIBMDatabaseUserValidate,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Description: "ID of the database instance the user belongs to",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description:  "User name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(5, 32),
+			},
+			"password": {
+				Description:      "User password. Changing the password rotates it in place. The password is not stored in state, only its hash.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				ValidateFunc:     validateDatabaseUserPassword,
+				DiffSuppressFunc: flex.SuppressSaltedHashedSecret,
+			},
+			"type": {
+				Description:  "User type",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "database",
+				ValidateFunc: validation.StringInSlice([]string{"database", "ops_manager", "read_only_replica"}, false),
+			},
+			"role": {
+				Description:  "User role. Only available for ops_manager user type.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"group_read_only", "group_data_access_admin"}, false),
+			},
+		},
+	}
+}
+
+// validateDatabaseUserPassword checks the complexity rules shared by every user type
+func validateDatabaseUserPassword(v interface{}, k string) (ws []string, errors []error) {
+	password := v.(string)
+	if len(password) < 15 || len(password) > 32 {
+		errors = append(errors, fmt.Errorf("%q must be between 15 and 32 characters long", k))
+	}
+	if !databaseUserPasswordLetter.MatchString(password) || !databaseUserPasswordNumber.MatchString(password) {
+		errors = append(errors, fmt.Errorf("%q must contain at least one letter and one number", k))
+	}
+	if strings.ContainsAny(password, " \t\n") {
+		errors = append(errors, fmt.Errorf("%q must not contain whitespace", k))
+	}
+	return
+}
+
+func resourceIBMDatabaseUserValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	userType := diff.Get("type").(string)
+	if role := diff.Get("role").(string); role != "" && userType != "ops_manager" {
+		return fmt.Errorf("[ERROR] role %s can only be set for the ops_manager user type", role)
+	}
+
+	// the password may be unknown until apply when it comes from another resource
+	if !diff.NewValueKnown("password") {
+		return nil
+	}
+	// the state only holds the hash of an unchanged password
+	if !diff.HasChange("password") {
+		return nil
+	}
+	password := diff.Get("password").(string)
+	if userType == "ops_manager" {
+		if !databaseUserPasswordSpecial.MatchString(password) {
+			return fmt.Errorf("[ERROR] The password of an ops_manager user must contain at least one special character")
+		}
+	} else if !databaseUserPasswordChars.MatchString(password) {
+		return fmt.Errorf("[ERROR] The password of a %s user must only contain letters, numbers, '-' and '_', and must start with a letter or a number", userType)
+	}
+	return nil
+}
+
+func parseDatabaseUserID(id string) (deploymentID, userType, userName string, err error) {
+	parts := strings.SplitN(id, databaseUserIDSeparator, 2)
+	if len(parts) != 2 || !strings.Contains(parts[1], "/") {
+		return "", "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of deploymentID%suserType/userName", id, databaseUserIDSeparator)
+	}
+	userParts := strings.SplitN(parts[1], "/", 2)
+	return parts[0], userParts[0], userParts[1], nil
+}
+
+func resourceIBMDatabaseUserCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+	userType := d.Get("type").(string)
+	userName := d.Get("name").(string)
+
+	user := &clouddatabasesv5.User{
+		Username: core.StringPtr(userName),
+		Password: core.StringPtr(d.Get("password").(string)),
+	}
+
+	// User Role only for ops_manager user type
+	if role, ok := d.GetOk("role"); ok && userType == "ops_manager" {
+		user.Role = core.StringPtr(role.(string))
+	}
+
+	createDatabaseUserOptions := &clouddatabasesv5.CreateDatabaseUserOptions{
+		ID:       &deploymentID,
+		UserType: &userType,
+		User:     user,
+	}
+
+	createDatabaseUserResponse, response, err := cloudDatabasesClient.CreateDatabaseUserWithContext(context, createDatabaseUserOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] CreateDatabaseUser (%s) failed %s\n%s", userName, err, response))
+	}
+
+	_, err = waitForDatabaseTaskComplete(*createDatabaseUserResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) user (%s) create task to complete: %s", deploymentID, userName, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s/%s", deploymentID, databaseUserIDSeparator, userType, userName))
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	deploymentID, userType, userName, err := parseDatabaseUserID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	//ICD does not implement a GetUsers API. The connection of the user is only found while the user exists.
+	endpointType, response, err := getDatabaseEndpointType(context, cloudDatabasesClient, deploymentID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Database instance %s not found, removing user %s from state", deploymentID, userName)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s) for user %s: %s", deploymentID, userName, err))
+	}
+
+	getConnectionOptions := &clouddatabasesv5.GetConnectionOptions{
+		ID:           core.StringPtr(deploymentID),
+		UserType:     core.StringPtr(userType),
+		UserID:       core.StringPtr(userName),
+		EndpointType: core.StringPtr(endpointType),
+	}
+	_, response, err = cloudDatabasesClient.GetConnectionWithContext(context, getConnectionOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] User %s not found on database instance %s, removing it from state", userName, deploymentID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s) user %s: %s", deploymentID, userName, err))
+	}
+
+	// The password and role are kept from the configuration.
+	d.Set("deployment_id", deploymentID)
+	d.Set("type", userType)
+	d.Set("name", userName)
+	if err = flex.SetHashedSecret(d, "password"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceIBMDatabaseUserUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChange("password") {
+		return resourceIBMDatabaseUserRead(context, d, meta)
+	}
+
+	deploymentID, userType, userName, err := parseDatabaseUserID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	changeUserPasswordOptions := &clouddatabasesv5.ChangeUserPasswordOptions{
+		ID:       &deploymentID,
+		UserType: &userType,
+		Username: &userName,
+		User: &clouddatabasesv5.APasswordSettingUser{
+			Password: core.StringPtr(d.Get("password").(string)),
+		},
+	}
+
+	changeUserPasswordResponse, response, err := cloudDatabasesClient.ChangeUserPasswordWithContext(context, changeUserPasswordOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			// The user was removed outside of terraform, create it again with the new password
+			return resourceIBMDatabaseUserCreate(context, d, meta)
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] ChangeUserPassword (%s) failed %s\n%s", userName, err, response))
+	}
+
+	_, err = waitForDatabaseTaskComplete(*changeUserPasswordResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) user (%s) password update task to complete: %s", deploymentID, userName, err))
+	}
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	deploymentID, userType, userName, err := parseDatabaseUserID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deleteDatabaseUserOptions := &clouddatabasesv5.DeleteDatabaseUserOptions{
+		ID:       &deploymentID,
+		UserType: &userType,
+		Username: &userName,
+	}
+
+	deleteDatabaseUserResponse, response, err := cloudDatabasesClient.DeleteDatabaseUserWithContext(context, deleteDatabaseUserOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] DeleteDatabaseUser (%s) failed %s\n%s", userName, err, response))
+	}
+
+	_, err = waitForDatabaseTaskComplete(*deleteDatabaseUserResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) user (%s) delete task to complete: %s", deploymentID, userName, err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseUser_basic(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	testName := fmt.Sprintf("tf-user-%d", acctest.RandIntRange(10, 100))
+	name := "ibm_database_user.user"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMDatabaseUserConfig(databaseResourceGroup, testName, "password12"),
+				ExpectError: regexp.MustCompile("must be between 15 and 32 characters long"),
+			},
+			{
+				Config: testAccCheckIBMDatabaseUserConfig(databaseResourceGroup, testName, "firstPassword123456"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", "appuser01"),
+					resource.TestCheckResourceAttr(name, "type", "database"),
+					resource.TestCheckResourceAttrSet(name, "deployment_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseUserConfig(databaseResourceGroup, testName, "secondPassword123456"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", "appuser01"),
+					resource.TestMatchResourceAttr(name, "password", regexp.MustCompile("^hash:SHA3-512:")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseUserConfig(databaseResourceGroup, name, password string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id            = data.ibm_resource_group.test_acc.id
+		name                         = "%[2]s"
+		service                      = "databases-for-postgresql"
+		plan                         = "standard"
+		location                     = "%[3]s"
+		adminpassword                = "password12"
+		members_memory_allocation_mb = 2048
+		members_disk_allocation_mb   = 10240
+	}
+
+	resource "ibm_database_user" "user" {
+		deployment_id = ibm_database.%[2]s.id
+		name          = "appuser01"
+		password      = "%[4]s"
+	}
+	`, databaseResourceGroup, name, acc.IcdDbRegion, password)
+}
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMEventStreamsConsumerGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMEventStreamsConsumerGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the Event Streams instance",
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API endpoint for interacting with Event Streams REST API",
+			},
+			"kafka_brokers_sasl": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Kafka brokers addresses for interacting with Kafka native API",
+			},
+			"group_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the consumer groups to describe. All the consumer groups are described when not set",
+			},
+			"consumer_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The consumer groups of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the consumer group",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the consumer group, for example Stable or Empty",
+						},
+						"members": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of members of the consumer group",
+						},
+						"total_lag": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The sum of the lag of every partition the consumer group committed an offset for",
+						},
+						"partitions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The partitions the consumer group committed an offset for",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the topic",
+									},
+									"partition": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The partition number",
+									},
+									"current_offset": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The offset committed by the consumer group",
+									},
+									"log_end_offset": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The offset of the next message produced to the partition",
+									},
+									"lag": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The number of messages the consumer group is behind the end of the partition",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMEventStreamsConsumerGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupsRead createSaramaAdminClient err %s", err)
+		return err
+	}
+	client, _, err := createSaramaClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupsRead createSaramaClient err %s", err)
+		return err
+	}
+	defer client.Close()
+
+	var groupIDs []string
+	if ids, ok := d.GetOk("group_ids"); ok {
+		for _, id := range ids.([]interface{}) {
+			groupIDs = append(groupIDs, id.(string))
+		}
+	} else {
+		groups, err := adminClient.ListConsumerGroups()
+		if err != nil {
+			log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupsRead ListConsumerGroups err %s", err)
+			return err
+		}
+		for id := range groups {
+			groupIDs = append(groupIDs, id)
+		}
+		sort.Strings(groupIDs)
+	}
+
+	descriptions := []*sarama.GroupDescription{}
+	if len(groupIDs) > 0 {
+		descriptions, err = adminClient.DescribeConsumerGroups(groupIDs)
+		if err != nil {
+			log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupsRead DescribeConsumerGroups err %s", err)
+			return err
+		}
+	}
+
+	consumerGroups := make([]map[string]interface{}, 0, len(descriptions))
+	for _, description := range descriptions {
+		if description.Err != sarama.ErrNoError {
+			log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupsRead DescribeConsumerGroups group %s err %v", description.GroupId, description.Err)
+			return fmt.Errorf("[ERROR] Error describing consumer group %s : %v", description.GroupId, description.Err)
+		}
+		partitions, totalLag, err := getConsumerGroupLag(adminClient, client, description.GroupId)
+		if err != nil {
+			return err
+		}
+		consumerGroups = append(consumerGroups, map[string]interface{}{
+			"group_id":   description.GroupId,
+			"state":      description.State,
+			"members":    len(description.Members),
+			"total_lag":  int(totalLag),
+			"partitions": partitions,
+		})
+	}
+
+	d.SetId(instanceCRN)
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set("consumer_groups", consumerGroups)
+	return nil
+}
+
+// getConsumerGroupLag compares the committed offsets of a group with the newest offset of each partition
+func getConsumerGroupLag(adminClient sarama.ClusterAdmin, client sarama.Client, groupID string) ([]map[string]interface{}, int64, error) {
+	offsets, err := adminClient.ListConsumerGroupOffsets(groupID, nil)
+	if err != nil {
+		log.Printf("[DEBUG]getConsumerGroupLag ListConsumerGroupOffsets group %s err %s", groupID, err)
+		return nil, 0, err
+	}
+
+	topics := make([]string, 0, len(offsets.Blocks))
+	for topic := range offsets.Blocks {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+
+	partitions := []map[string]interface{}{}
+	var totalLag int64
+	for _, topic := range topics {
+		partitionIDs := make([]int, 0, len(offsets.Blocks[topic]))
+		for partition := range offsets.Blocks[topic] {
+			partitionIDs = append(partitionIDs, int(partition))
+		}
+		sort.Ints(partitionIDs)
+
+		for _, partition := range partitionIDs {
+			block := offsets.Blocks[topic][int32(partition)]
+			// no offset was committed for the partition
+			if block.Err != sarama.ErrNoError || block.Offset < 0 {
+				continue
+			}
+			logEndOffset, err := client.GetOffset(topic, int32(partition), sarama.OffsetNewest)
+			if err != nil {
+				log.Printf("[DEBUG]getConsumerGroupLag GetOffset topic %s partition %d err %s", topic, partition, err)
+				return nil, 0, err
+			}
+			lag := logEndOffset - block.Offset
+			if lag < 0 {
+				lag = 0
+			}
+			totalLag += lag
+			partitions = append(partitions, map[string]interface{}{
+				"topic":          topic,
+				"partition":      partition,
+				"current_offset": int(block.Offset),
+				"log_end_offset": int(logEndOffset),
+				"lag":            int(lag),
+			})
+		}
+	}
+	return partitions, totalLag, nil
+}
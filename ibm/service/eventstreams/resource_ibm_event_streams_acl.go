@@ -0,0 +1,248 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	// aclIDFields are the fields of the ACL binding in the order of the ID
+	aclIDFields      = []string{"resource_type", "pattern_type", "resource_name", "operation", "permission_type", "host", "principal"}
+	aclResourceTypes = map[string]sarama.AclResourceType{
+		"topic":            sarama.AclResourceTopic,
+		"group":            sarama.AclResourceGroup,
+		"cluster":          sarama.AclResourceCluster,
+		"transactional_id": sarama.AclResourceTransactionalID,
+	}
+	aclPatternTypes = map[string]sarama.AclResourcePatternType{
+		"literal":  sarama.AclPatternLiteral,
+		"prefixed": sarama.AclPatternPrefixed,
+	}
+	aclOperations = map[string]sarama.AclOperation{
+		"all":              sarama.AclOperationAll,
+		"read":             sarama.AclOperationRead,
+		"write":            sarama.AclOperationWrite,
+		"create":           sarama.AclOperationCreate,
+		"delete":           sarama.AclOperationDelete,
+		"alter":            sarama.AclOperationAlter,
+		"describe":         sarama.AclOperationDescribe,
+		"describe_configs": sarama.AclOperationDescribeConfigs,
+		"alter_configs":    sarama.AclOperationAlterConfigs,
+		"idempotent_write": sarama.AclOperationIdempotentWrite,
+	}
+	aclPermissionTypes = map[string]sarama.AclPermissionType{
+		"allow": sarama.AclPermissionAllow,
+		"deny":  sarama.AclPermissionDeny,
+	}
+)
+
+func ResourceIBMEventStreamsACL() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMEventStreamsACLCreate,
+		Read:     resourceIBMEventStreamsACLRead,
+		Delete:   resourceIBMEventStreamsACLDelete,
+		Importer: &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Description: "The CRN of the Event Streams instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API endpoint for interacting with Event Streams REST API",
+			},
+			"kafka_brokers_sasl": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Kafka brokers addresses for interacting with Kafka native API",
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Description:  "The type of the Kafka resource the ACL applies to: topic, group, cluster or transactional_id",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"topic", "group", "cluster", "transactional_id"}),
+			},
+			"resource_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the Kafka resource the ACL applies to. Use kafka-cluster for the cluster resource type",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"pattern_type": {
+				Type:         schema.TypeString,
+				Description:  "How the resource name is matched: literal or prefixed",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "literal",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"literal", "prefixed"}),
+			},
+			"principal": {
+				Type:        schema.TypeString,
+				Description: "The principal the ACL applies to, for example User:iam-ServiceId-00000000-0000-0000-0000-000000000000",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Description: "The host the principal connects from",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "*",
+			},
+			"operation": {
+				Type:         schema.TypeString,
+				Description:  "The operation allowed or denied on the resource",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"all", "read", "write", "create", "delete", "alter", "describe", "describe_configs", "alter_configs", "idempotent_write"}),
+			},
+			"permission_type": {
+				Type:         schema.TypeString,
+				Description:  "Whether the operation is allowed or denied: allow or deny",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "allow",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"allow", "deny"}),
+			},
+		},
+	}
+}
+
+func resourceIBMEventStreamsACLCreate(d *schema.ResourceData, meta interface{}) error {
+	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLCreate createSaramaAdminClient err %s", err)
+		return err
+	}
+	resource, acl := expandEventStreamsACL(d)
+	err = adminClient.CreateACL(resource, acl)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLCreate CreateACL err %s", err)
+		return err
+	}
+	log.Printf("[INFO] resourceIBMEventStreamsACLCreate CreateACL: resource is %v, acl is %v", resource, acl)
+	d.SetId(getACLID(instanceCRN, d))
+	return resourceIBMEventStreamsACLRead(d, meta)
+}
+
+func resourceIBMEventStreamsACLRead(d *schema.ResourceData, meta interface{}) error {
+	aclFields, err := parseACLID(d.Id())
+	if err != nil {
+		return err
+	}
+	// the instance CRN can not be derived from the ACL ID by createSaramaAdminClient on import
+	d.Set("resource_instance_id", aclFields["resource_instance_id"])
+	adminClient, _, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLRead createSaramaAdminClient err %s", err)
+		return err
+	}
+	for k, v := range aclFields {
+		d.Set(k, v)
+	}
+	resource, acl := expandEventStreamsACL(d)
+	resourceAcls, err := adminClient.ListAcls(aclFilter(resource, acl))
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLRead ListAcls err %s", err)
+		return err
+	}
+	if len(resourceAcls) == 0 || len(resourceAcls[0].Acls) == 0 {
+		log.Printf("[INFO] resourceIBMEventStreamsACLRead acl %s does not exist", d.Id())
+		d.SetId("")
+	}
+	return nil
+}
+
+func resourceIBMEventStreamsACLDelete(d *schema.ResourceData, meta interface{}) error {
+	adminClient, _, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLDelete createSaramaAdminClient err %s", err)
+		return err
+	}
+	resource, acl := expandEventStreamsACL(d)
+	matchingAcls, err := adminClient.DeleteACL(aclFilter(resource, acl), false)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLDelete DeleteACL err %s", err)
+		return err
+	}
+	for _, matchingAcl := range matchingAcls {
+		if matchingAcl.Err != sarama.ErrNoError {
+			log.Printf("[DEBUG] resourceIBMEventStreamsACLDelete DeleteACL err %v", matchingAcl.Err)
+			return matchingAcl.Err
+		}
+	}
+	d.SetId("")
+	log.Printf("[INFO] resourceIBMEventStreamsACLDelete acl %v deleted", acl)
+	return nil
+}
+
+func expandEventStreamsACL(d *schema.ResourceData) (sarama.Resource, sarama.Acl) {
+	resource := sarama.Resource{
+		ResourceType:        aclResourceTypes[d.Get("resource_type").(string)],
+		ResourceName:        d.Get("resource_name").(string),
+		ResourcePatternType: aclPatternTypes[d.Get("pattern_type").(string)],
+	}
+	acl := sarama.Acl{
+		Principal:      d.Get("principal").(string),
+		Host:           d.Get("host").(string),
+		Operation:      aclOperations[d.Get("operation").(string)],
+		PermissionType: aclPermissionTypes[d.Get("permission_type").(string)],
+	}
+	return resource, acl
+}
+
+// aclFilter matches exactly one ACL binding
+func aclFilter(resource sarama.Resource, acl sarama.Acl) sarama.AclFilter {
+	return sarama.AclFilter{
+		ResourceType:              resource.ResourceType,
+		ResourceName:              &resource.ResourceName,
+		ResourcePatternTypeFilter: resource.ResourcePatternType,
+		Principal:                 &acl.Principal,
+		Host:                      &acl.Host,
+		Operation:                 acl.Operation,
+		PermissionType:            acl.PermissionType,
+	}
+}
+
+// getACLID appends the ACL binding to the instance CRN. The fields are escaped, as resource names,
+// IPv6 hosts and principals can contain a colon.
+func getACLID(instanceCRN string, d *schema.ResourceData) string {
+	crnSegments := strings.Split(instanceCRN, ":")[:8]
+	crnSegments = append(crnSegments, "acl")
+	for _, field := range aclIDFields {
+		crnSegments = append(crnSegments, url.QueryEscape(d.Get(field).(string)))
+	}
+	return strings.Join(crnSegments, ":")
+}
+
+func parseACLID(aclID string) (map[string]string, error) {
+	segments := strings.Split(aclID, ":")
+	if len(segments) != 9+len(aclIDFields) || segments[8] != "acl" {
+		return nil, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be the instance CRN followed by acl:resource_type:pattern_type:resource_name:operation:permission_type:host:principal", aclID)
+	}
+	aclFields := map[string]string{
+		"resource_instance_id": strings.Join(append(segments[:8:8], "", ""), ":"),
+	}
+	for i, field := range aclIDFields {
+		value, err := url.QueryUnescape(segments[9+i])
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Incorrect ID %s: %s", aclID, err)
+		}
+		aclFields[field] = value
+	}
+	return aclFields, nil
+}
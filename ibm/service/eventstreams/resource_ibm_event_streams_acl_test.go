@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEventStreamsACLResourceWithExistingInstance(t *testing.T) {
+	topicName := fmt.Sprintf("es_topic_%d", acctest.RandInt())
+	principal := "User:iam-ServiceId-00000000-0000-0000-0000-000000000000"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsACLWithExistingInstance(existingInstanceName, topicName, principal),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_event_streams_acl.es_acl", "id"),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "resource_type", "topic"),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "resource_name", topicName),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "pattern_type", "literal"),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "principal", principal),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "host", "*"),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "operation", "read"),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "permission_type", "allow"),
+				),
+			},
+			{
+				ResourceName:            "ibm_event_streams_acl.es_acl",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"kafka_http_url", "kafka_brokers_sasl"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsACLWithExistingInstance(instanceName, topicName, principal string) string {
+	return getPlatformResource(instanceName) + "\n" +
+		createEventStreamsTopicResourceWithoutConfig(false, topicName, 1) + "\n" +
+		fmt.Sprintf(`
+		resource "ibm_event_streams_acl" "es_acl" {
+		  resource_instance_id = data.ibm_resource_instance.es_instance.id
+		  resource_type        = "topic"
+		  resource_name        = ibm_event_streams_topic.es_topic.name
+		  principal            = "%s"
+		  operation            = "read"
+		}`, principal)
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// The target instance must have been created with mirroring enabled from a source instance
+var mirroringTargetInstanceName = "mh-preprod-customer-us-south-mirroring"
+
+func TestAccIBMEventStreamsMirroringConfigResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsMirroringConfig(mirroringTargetInstanceName, `"orders.*"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_event_streams_mirroring_config.es_mirroring", "id"),
+					resource.TestCheckResourceAttrSet("ibm_event_streams_mirroring_config.es_mirroring", "kafka_http_url"),
+					resource.TestCheckResourceAttr("ibm_event_streams_mirroring_config.es_mirroring", "mirroring_topic_patterns.#", "1"),
+					resource.TestCheckResourceAttr("ibm_event_streams_mirroring_config.es_mirroring", "mirroring_topic_patterns.0", "orders.*"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsMirroringConfig(mirroringTargetInstanceName, `"orders.*", "payments"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_mirroring_config.es_mirroring", "mirroring_topic_patterns.#", "2"),
+					resource.TestCheckResourceAttr("ibm_event_streams_mirroring_config.es_mirroring", "mirroring_topic_patterns.1", "payments"),
+				),
+			},
+			{
+				ResourceName:      "ibm_event_streams_mirroring_config.es_mirroring",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsMirroringConfig(instanceName, patterns string) string {
+	return getPlatformResource(instanceName) + "\n" +
+		fmt.Sprintf(`
+		resource "ibm_event_streams_mirroring_config" "es_mirroring" {
+		  resource_instance_id     = data.ibm_resource_instance.es_instance.id
+		  mirroring_topic_patterns = [%s]
+		}`, patterns)
+}
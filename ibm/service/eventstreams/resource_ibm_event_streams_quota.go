@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// the quota keys of Kafka are also used as argument names
+const (
+	defaultQuotaEntity = "default"
+	producerByteRate   = "producer_byte_rate"
+	consumerByteRate   = "consumer_byte_rate"
+)
+
+func ResourceIBMEventStreamsQuota() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMEventStreamsQuotaCreate,
+		Read:     resourceIBMEventStreamsQuotaRead,
+		Update:   resourceIBMEventStreamsQuotaUpdate,
+		Delete:   resourceIBMEventStreamsQuotaDelete,
+		Importer: &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Description: "The CRN of the Event Streams instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API endpoint for interacting with Event Streams REST API",
+			},
+			"kafka_brokers_sasl": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Kafka brokers addresses for interacting with Kafka native API",
+			},
+			"entity": {
+				Type:        schema.TypeString,
+				Description: "The entity the quota applies to: an IAM ID like iam-ServiceId-00000000-0000-0000-0000-000000000000, or default for the quota of every user without a quota of their own",
+				Required:    true,
+				ForceNew:    true,
+			},
+			producerByteRate: {
+				Type:         schema.TypeInt,
+				Description:  "The producer byte rate quota value, in bytes per second",
+				Optional:     true,
+				AtLeastOneOf: []string{producerByteRate, consumerByteRate},
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			consumerByteRate: {
+				Type:         schema.TypeInt,
+				Description:  "The consumer byte rate quota value, in bytes per second",
+				Optional:     true,
+				AtLeastOneOf: []string{producerByteRate, consumerByteRate},
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceIBMEventStreamsQuotaCreate(d *schema.ResourceData, meta interface{}) error {
+	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsQuotaCreate createSaramaAdminClient err %s", err)
+		return err
+	}
+	entity := d.Get("entity").(string)
+	for _, key := range []string{producerByteRate, consumerByteRate} {
+		if value, ok := d.GetOk(key); ok {
+			err = alterEventStreamsQuota(adminClient, entity, key, value.(int))
+			if err != nil {
+				log.Printf("[DEBUG] resourceIBMEventStreamsQuotaCreate AlterClientQuotas err %s", err)
+				return err
+			}
+		}
+	}
+	log.Printf("[INFO] resourceIBMEventStreamsQuotaCreate quota of entity %s is created", entity)
+	d.SetId(getQuotaID(instanceCRN, entity))
+	return resourceIBMEventStreamsQuotaRead(d, meta)
+}
+
+func resourceIBMEventStreamsQuotaRead(d *schema.ResourceData, meta interface{}) error {
+	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsQuotaRead createSaramaAdminClient err %s", err)
+		return err
+	}
+	entity, err := getQuotaEntity(d.Id())
+	if err != nil {
+		return err
+	}
+	entries, err := adminClient.DescribeClientQuotas([]sarama.QuotaFilterComponent{quotaFilter(entity)}, true)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsQuotaRead DescribeClientQuotas err %s", err)
+		return err
+	}
+	if len(entries) == 0 {
+		log.Printf("[INFO] resourceIBMEventStreamsQuotaRead quota of entity %s does not exist", entity)
+		d.SetId("")
+		return nil
+	}
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set("entity", entity)
+	d.Set(producerByteRate, int(entries[0].Values[producerByteRate]))
+	d.Set(consumerByteRate, int(entries[0].Values[consumerByteRate]))
+	return nil
+}
+
+func resourceIBMEventStreamsQuotaUpdate(d *schema.ResourceData, meta interface{}) error {
+	adminClient, _, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsQuotaUpdate createSaramaAdminClient err %s", err)
+		return err
+	}
+	entity := d.Get("entity").(string)
+	for _, key := range []string{producerByteRate, consumerByteRate} {
+		if d.HasChange(key) {
+			// a rate removed from the configuration is removed from the quota
+			err = alterEventStreamsQuota(adminClient, entity, key, d.Get(key).(int))
+			if err != nil {
+				log.Printf("[DEBUG] resourceIBMEventStreamsQuotaUpdate AlterClientQuotas err %s", err)
+				return err
+			}
+			log.Printf("[INFO] resourceIBMEventStreamsQuotaUpdate %s of entity %s is set to %d", key, entity, d.Get(key).(int))
+		}
+	}
+	return resourceIBMEventStreamsQuotaRead(d, meta)
+}
+
+func resourceIBMEventStreamsQuotaDelete(d *schema.ResourceData, meta interface{}) error {
+	adminClient, _, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsQuotaDelete createSaramaAdminClient err %s", err)
+		return err
+	}
+	entity := d.Get("entity").(string)
+	for _, key := range []string{producerByteRate, consumerByteRate} {
+		err = alterEventStreamsQuota(adminClient, entity, key, 0)
+		if err != nil {
+			log.Printf("[DEBUG] resourceIBMEventStreamsQuotaDelete AlterClientQuotas err %s", err)
+			return err
+		}
+	}
+	d.SetId("")
+	log.Printf("[INFO] resourceIBMEventStreamsQuotaDelete quota of entity %s deleted", entity)
+	return nil
+}
+
+// alterEventStreamsQuota sets a quota value of an entity, a value of 0 removes it
+func alterEventStreamsQuota(adminClient sarama.ClusterAdmin, entity string, key string, value int) error {
+	entityComponent := sarama.QuotaEntityComponent{
+		EntityType: sarama.QuotaEntityUser,
+		MatchType:  sarama.QuotaMatchExact,
+		Name:       entity,
+	}
+	if entity == defaultQuotaEntity {
+		entityComponent = sarama.QuotaEntityComponent{
+			EntityType: sarama.QuotaEntityUser,
+			MatchType:  sarama.QuotaMatchDefault,
+		}
+	}
+	op := sarama.ClientQuotasOp{
+		Key:    key,
+		Value:  float64(value),
+		Remove: value == 0,
+	}
+	return adminClient.AlterClientQuotas([]sarama.QuotaEntityComponent{entityComponent}, op, false)
+}
+
+func quotaFilter(entity string) sarama.QuotaFilterComponent {
+	if entity == defaultQuotaEntity {
+		return sarama.QuotaFilterComponent{
+			EntityType: sarama.QuotaEntityUser,
+			MatchType:  sarama.QuotaMatchDefault,
+		}
+	}
+	return sarama.QuotaFilterComponent{
+		EntityType: sarama.QuotaEntityUser,
+		MatchType:  sarama.QuotaMatchExact,
+		Match:      entity,
+	}
+}
+
+func getQuotaID(instanceCRN string, entity string) string {
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = "quota"
+	crnSegments[9] = url.QueryEscape(entity)
+	return strings.Join(crnSegments, ":")
+}
+
+// getQuotaEntity returns the entity of a quota ID, the entity is escaped as it can contain a colon
+func getQuotaEntity(quotaID string) (string, error) {
+	crnSegments := strings.Split(quotaID, ":")
+	if len(crnSegments) != 10 || crnSegments[8] != "quota" || crnSegments[9] == "" {
+		return "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be the instance CRN followed by quota:entity", quotaID)
+	}
+	entity, err := url.QueryUnescape(crnSegments[9])
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Incorrect ID %s: %s", quotaID, err)
+	}
+	return entity, nil
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEventStreamsQuotaResourceWithExistingInstance(t *testing.T) {
+	entity := "iam-ServiceId-00000000-0000-0000-0000-000000000000"
+	producerByteRate := 1048576
+	consumerByteRate := 2097152
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsQuotaWithExistingInstance(MZREnterpriseInstanceName, entity, producerByteRate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_event_streams_quota.es_quota", "id"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "entity", entity),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "producer_byte_rate", strconv.Itoa(producerByteRate)),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "consumer_byte_rate", "0"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsQuotaWithConsumerByteRate(MZREnterpriseInstanceName, entity, producerByteRate, consumerByteRate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "entity", entity),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "producer_byte_rate", strconv.Itoa(producerByteRate)),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "consumer_byte_rate", strconv.Itoa(consumerByteRate)),
+				),
+			},
+			{
+				ResourceName:            "ibm_event_streams_quota.es_quota",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"kafka_http_url", "kafka_brokers_sasl"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsQuotaWithExistingInstance(instanceName, entity string, producerByteRate int) string {
+	return getPlatformResource(instanceName) + "\n" +
+		fmt.Sprintf(`
+		resource "ibm_event_streams_quota" "es_quota" {
+		  resource_instance_id = data.ibm_resource_instance.es_instance.id
+		  entity               = "%s"
+		  producer_byte_rate   = %d
+		}`, entity, producerByteRate)
+}
+
+func testAccCheckIBMEventStreamsQuotaWithConsumerByteRate(instanceName, entity string, producerByteRate, consumerByteRate int) string {
+	return getPlatformResource(instanceName) + "\n" +
+		fmt.Sprintf(`
+		resource "ibm_event_streams_quota" "es_quota" {
+		  resource_instance_id = data.ibm_resource_instance.es_instance.id
+		  entity               = "%s"
+		  producer_byte_rate   = %d
+		  consumer_byte_rate   = %d
+		}`, entity, producerByteRate, consumerByteRate)
+}
@@ -324,7 +324,7 @@ func getInstanceURL(d *schema.ResourceData, meta interface{}) (string, string, e
 	planID := *instance.ResourcePlanID
 	valid := strings.Contains(planID, "enterprise")
 	if !valid {
-		return "", "", fmt.Errorf("schema registry is not supported by the Event Streams %s plan, enterprise plan is expected",
+		return "", "", fmt.Errorf("schema registry and mirroring are not supported by the Event Streams %s plan, enterprise plan is expected",
 			planID)
 	}
 	d.Set("kafka_http_url", adminURL)
@@ -4,6 +4,7 @@
 package eventstreams
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -46,12 +47,13 @@ var (
 
 func ResourceIBMEventStreamsTopic() *schema.Resource {
 	return &schema.Resource{
-		Exists:   resourceIBMEventStreamsTopicExists,
-		Create:   resourceIBMEventStreamsTopicCreate,
-		Read:     resourceIBMEventStreamsTopicRead,
-		Update:   resourceIBMEventStreamsTopicUpdate,
-		Delete:   resourceIBMEventStreamsTopicDelete,
-		Importer: &schema.ResourceImporter{},
+		Exists:        resourceIBMEventStreamsTopicExists,
+		Create:        resourceIBMEventStreamsTopicCreate,
+		Read:          resourceIBMEventStreamsTopicRead,
+		Update:        resourceIBMEventStreamsTopicUpdate,
+		Delete:        resourceIBMEventStreamsTopicDelete,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMEventStreamsTopicPartitionsValidate,
 		Schema: map[string]*schema.Schema{
 			"resource_instance_id": {
 				Type:        schema.TypeString,
@@ -76,7 +78,7 @@ func ResourceIBMEventStreamsTopic() *schema.Resource {
 			},
 			"partitions": {
 				Type:        schema.TypeInt,
-				Description: "The number of partitions",
+				Description: "The number of partitions. Partitions can only be increased, which changes the partition of keyed messages",
 				Optional:    true,
 				Default:     1,
 			},
@@ -120,6 +122,23 @@ func resourceIBMEventStreamsTopicExists(d *schema.ResourceData, meta interface{}
 	return true, nil
 }
 
+// resourceIBMEventStreamsTopicPartitionsValidate rejects a decrease of partitions during plan, Kafka can not remove partitions.
+// An increase changes the partition keyed messages are produced to, so their order is only kept for messages produced after it,
+// which is logged as a warning.
+func resourceIBMEventStreamsTopicPartitionsValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("partitions") {
+		return nil
+	}
+	o, n := diff.GetChange("partitions")
+	oldPartitions, newPartitions := o.(int), n.(int)
+	if newPartitions < oldPartitions {
+		return fmt.Errorf("[ERROR] The partitions of topic %s can not be decreased from %d to %d, the topic must be recreated", diff.Get("name").(string), oldPartitions, newPartitions)
+	}
+	log.Printf("[WARN] Increasing the partitions of topic %s from %d to %d changes the partition messages with a key are produced to, "+
+		"the ordering of keyed messages is not guaranteed across the change", diff.Get("name").(string), oldPartitions, newPartitions)
+	return nil
+}
+
 func resourceIBMEventStreamsTopicCreate(d *schema.ResourceData, meta interface{}) error {
 	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
 	if err != nil {
@@ -251,32 +270,59 @@ func resourceIBMEventStreamsTopicDelete(d *schema.ResourceData, meta interface{}
 }
 
 func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.ClusterAdmin, string, error) {
+	brokerAddress, config, instanceCRN, err := getSaramaConfig(d, meta)
+	if err != nil {
+		return nil, "", err
+	}
+	config.Admin.Timeout = adminClientTimeout
+	adminClient, err := sarama.NewClusterAdmin(brokerAddress, config)
+	if err != nil {
+		log.Printf("[DEBUG] createSaramaAdminClient NewClusterAdmin err %s", err)
+		return nil, "", err
+	}
+	clientPool[instanceCRN] = adminClient
+	log.Printf("[INFO] createSaramaAdminClient instance %s 's client is initialized", instanceCRN)
+	return adminClient, instanceCRN, nil
+}
+
+// createSaramaClient returns a Kafka client for the APIs the admin client does not expose, like partition offsets.
+// The caller is responsible for closing it.
+func createSaramaClient(d *schema.ResourceData, meta interface{}) (sarama.Client, string, error) {
+	brokerAddress, config, instanceCRN, err := getSaramaConfig(d, meta)
+	if err != nil {
+		return nil, "", err
+	}
+	client, err := sarama.NewClient(brokerAddress, config)
+	if err != nil {
+		log.Printf("[DEBUG] createSaramaClient NewClient err %s", err)
+		return nil, "", err
+	}
+	return client, instanceCRN, nil
+}
+
+func getSaramaConfig(d *schema.ResourceData, meta interface{}) ([]string, *sarama.Config, string, error) {
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
 		log.Printf("[DEBUG] createSaramaAdminClient BluemixSession err %s", err)
-		return nil, "", err
+		return nil, nil, "", err
 	}
 	apiKey := bxSession.Config.BluemixAPIKey
 	if len(apiKey) == 0 {
 		log.Printf("[DEBUG] createSaramaAdminClient BluemixAPIKey is empty")
-		return nil, "", fmt.Errorf("failed to get IBM cloud API key")
-	}
-	if err != nil {
-		log.Printf("[DEBUG] createSaramaAdminClient ResourceControllerAPI err %s", err)
-		return nil, "", err
+		return nil, nil, "", fmt.Errorf("failed to get IBM cloud API key")
 	}
 	instanceCRN := d.Get("resource_instance_id").(string)
 	if len(instanceCRN) == 0 {
 		topicID := d.Id()
 		if len(topicID) == 0 || !strings.Contains(topicID, ":") {
 			log.Printf("[DEBUG] createSaramaAdminClient resource_instance_id is missing")
-			return nil, "", fmt.Errorf("resource_instance_id is required")
+			return nil, nil, "", fmt.Errorf("resource_instance_id is required")
 		}
 		instanceCRN = getInstanceCRN(topicID)
 	}
 	instance, err := getInstanceDetails(instanceCRN, meta)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, "", err
 	}
 	adminURL := instance.Extensions["kafka_http_url"].(string)
 	d.Set("kafka_http_url", adminURL)
@@ -296,15 +342,7 @@ func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.C
 	config.Net.SASL.Password = apiKey
 	config.Net.TLS.Enable = true
 	config.Version = brokerVersion
-	config.Admin.Timeout = adminClientTimeout
-	adminClient, err := sarama.NewClusterAdmin(brokerAddress, config)
-	if err != nil {
-		log.Printf("[DEBUG] createSaramaAdminClient NewClusterAdmin err %s", err)
-		return nil, "", err
-	}
-	clientPool[instanceCRN] = adminClient
-	log.Printf("[INFO] createSaramaAdminClient instance %s 's client is initialized", instanceCRN)
-	return adminClient, instanceCRN, nil
+	return brokerAddress, config, instanceCRN, nil
 }
 
 func topicDetail2Config(topicConfigEntries map[string]*string) map[string]*string {
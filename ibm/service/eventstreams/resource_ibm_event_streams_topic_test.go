@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -120,6 +121,33 @@ func TestAccIBMEventStreamsTopicResourceWithExistingInstance(t *testing.T) {
 	})
 }
 
+func TestAccIBMEventStreamsTopicPartitionsDecrease(t *testing.T) {
+	topicName := fmt.Sprintf("es_topic_%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsTopicWithExistingInstanceWithoutConfig(existingInstanceName, topicName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMEventStreamsTopicExists("ibm_event_streams_topic.es_topic", topicName),
+					resource.TestCheckResourceAttr("ibm_event_streams_topic.es_topic", "partitions", "2"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMEventStreamsTopicWithExistingInstanceWithoutConfig(existingInstanceName, topicName, 1),
+				ExpectError: regexp.MustCompile("can not be decreased"),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsTopicWithExistingInstanceWithoutConfig(existingInstanceName, topicName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_topic.es_topic", "partitions", "3"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMEventStreamsTopicImport(t *testing.T) {
 	instanceName := fmt.Sprintf("terraform_support_%d", acctest.RandInt())
 	planID := "standard"
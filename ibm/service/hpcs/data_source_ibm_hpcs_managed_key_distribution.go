@@ -0,0 +1,274 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package hpcs
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/ibm-hpcs-uko-sdk/ukov4"
+)
+
+func DataSourceIbmManagedKeyDistribution() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceIbmManagedKeyDistributionRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the UKO instance this resource exists in.",
+			},
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The region of the UKO instance this resource exists in.",
+			},
+			"key_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "UUID of the key.",
+			},
+			"uko_vault": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The UUID of the Vault in which the update is to take place.",
+			},
+			"state": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the key.",
+			},
+			"synced": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the key is in the expected state in all keystores of its keystore group.",
+			},
+			"instances": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The instances of the key in the keystores.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The v4 UUID used to uniquely identify the resource, as specified by RFC 4122.",
+						},
+						"label_in_keystore": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The label of the key in the keystore.",
+						},
+						"type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the key instance.",
+						},
+						"keystore_group": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The keystore group of the keystore.",
+						},
+						"keystore_type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of keystore.",
+						},
+					},
+				},
+			},
+			"status_in_keystores": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The status of the key in each keystore of its keystore group.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keystore_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The v4 UUID of the keystore.",
+						},
+						"keystore_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the keystore.",
+						},
+						"keystore_type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of keystore.",
+						},
+						"status": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the key in the keystore: not_present, active, not_active, wrong_key or error.",
+						},
+						"key_id_in_keystore": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the key in the keystore.",
+						},
+						"health_status": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The health of the keystore: ok, not_responding or configuration_error.",
+						},
+						"last_heartbeat": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date of last successful communication with the keystore.",
+						},
+						"errors": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The errors of the key in the keystore.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"code": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Identifier of the error.",
+									},
+									"message": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "A message explaining the problem, with potential suggestions how to address them.",
+									},
+									"more_info": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "A publicly-accessible URL where information about the error can be read in a web browser.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIbmManagedKeyDistributionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ukoClient, err := meta.(conns.ClientSession).UkoV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := d.Get("region").(string)
+	instance_id := d.Get("instance_id").(string)
+	vault_id := d.Get("uko_vault").(string)
+	key_id := d.Get("key_id").(string)
+
+	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ukoClient.SetServiceURL(url)
+
+	getManagedKeyOptions := &ukov4.GetManagedKeyOptions{}
+	getManagedKeyOptions.SetID(key_id)
+	getManagedKeyOptions.SetUKOVault(vault_id)
+	managedKey, response, err := ukoClient.GetManagedKeyWithContext(context, getManagedKeyOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetManagedKeyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetManagedKeyWithContext failed %s\n%s", err, response))
+	}
+
+	getKeyDistributionStatusOptions := &ukov4.GetKeyDistributionStatusForKeystoresOptions{}
+	getKeyDistributionStatusOptions.SetID(key_id)
+	getKeyDistributionStatusOptions.SetUKOVault(vault_id)
+	statusInKeystores, response, err := ukoClient.GetKeyDistributionStatusForKeystoresWithContext(context, getKeyDistributionStatusOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetKeyDistributionStatusForKeystoresWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetKeyDistributionStatusForKeystoresWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instance_id, vault_id, key_id))
+
+	if err = d.Set("state", managedKey.State); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting state %s", err))
+	}
+
+	instances := []map[string]interface{}{}
+	for _, instance := range managedKey.Instances {
+		instanceMap := map[string]interface{}{
+			"id":                instance.ID,
+			"label_in_keystore": instance.LabelInKeystore,
+			"type":              instance.Type,
+		}
+		if instance.Keystore != nil {
+			instanceMap["keystore_group"] = instance.Keystore.Group
+			instanceMap["keystore_type"] = instance.Keystore.Type
+		}
+		instances = append(instances, instanceMap)
+	}
+	if err = d.Set("instances", instances); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instances %s", err))
+	}
+
+	synced := true
+	keystores := []map[string]interface{}{}
+	for _, statusInKeystore := range statusInKeystores.StatusInKeystores {
+		keystoreMap := DataSourceIbmManagedKeyDistributionStatusInKeystoreToMap(&statusInKeystore)
+		if statusInKeystore.Keystore != nil && statusInKeystore.Keystore.ID != nil {
+			getKeystoreStatusOptions := &ukov4.GetKeystoreStatusOptions{}
+			getKeystoreStatusOptions.SetID(*statusInKeystore.Keystore.ID)
+			getKeystoreStatusOptions.SetUKOVault(vault_id)
+			keystoreStatus, response, err := ukoClient.GetKeystoreStatusWithContext(context, getKeystoreStatusOptions)
+			if err != nil {
+				log.Printf("[WARN] GetKeystoreStatusWithContext failed %s\n%s", err, response)
+			} else {
+				keystoreMap["health_status"] = keystoreStatus.HealthStatus
+				keystoreMap["last_heartbeat"] = flex.DateTimeToString(keystoreStatus.LastHeartbeat)
+			}
+		}
+		status := ""
+		if statusInKeystore.Status != nil {
+			status = *statusInKeystore.Status
+		}
+		if status != ukov4.StatusInKeystore_Status_Active && status != ukov4.StatusInKeystore_Status_NotActive {
+			synced = false
+		}
+		keystores = append(keystores, keystoreMap)
+	}
+	if err = d.Set("status_in_keystores", keystores); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status_in_keystores %s", err))
+	}
+	if err = d.Set("synced", synced); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting synced %s", err))
+	}
+
+	return nil
+}
+
+func DataSourceIbmManagedKeyDistributionStatusInKeystoreToMap(model *ukov4.StatusInKeystore) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	if model.Keystore != nil {
+		modelMap["keystore_id"] = model.Keystore.ID
+		modelMap["keystore_name"] = model.Keystore.Name
+		modelMap["keystore_type"] = model.Keystore.Type
+	}
+	modelMap["status"] = model.Status
+	modelMap["key_id_in_keystore"] = model.KeyIdInKeystore
+	errors := []map[string]interface{}{}
+	if model.Error != nil {
+		for _, errorModel := range model.Error.Errors {
+			errors = append(errors, map[string]interface{}{
+				"code":      errorModel.Code,
+				"message":   errorModel.Message,
+				"more_info": errorModel.MoreInfo,
+			})
+		}
+	}
+	modelMap["errors"] = errors
+	return modelMap
+}
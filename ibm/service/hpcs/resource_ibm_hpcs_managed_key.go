@@ -5,6 +5,7 @@ package hpcs
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -26,6 +27,7 @@ func ResourceIbmManagedKey() *schema.Resource {
 		UpdateContext: ResourceIbmManagedKeyUpdate,
 		DeleteContext: ResourceIbmManagedKeyDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: ResourceIbmManagedKeyCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": &schema.Schema{
@@ -132,10 +134,16 @@ func ResourceIbmManagedKey() *schema.Resource {
 				},
 			},
 			"state": &schema.Schema{
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_hpcs_managed_key", "state"),
+				Description:  "The state of the key. The key moves through pre_activation, active, deactivated and destroyed, a deactivated key can be activated again.",
+			},
+			"sync_trigger": &schema.Schema{
 				Type:        schema.TypeString,
-				Computed:    true,
 				Optional:    true,
-				Description: "The state of the key.",
+				Description: "Any change of the value syncs the key to the keystores of its keystore group again.",
 			},
 			"size": &schema.Schema{
 				Type:        schema.TypeString,
@@ -314,6 +322,13 @@ func ResourceIbmManagedKeyValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             100,
 		},
+		validate.ValidateSchema{
+			Identifier:                 "state",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "pre_activation, active, deactivated, destroyed",
+		},
 		validate.ValidateSchema{
 			Identifier:                 "description",
 			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
@@ -379,6 +394,13 @@ func ResourceIbmManagedKeyCreate(context context.Context, d *schema.ResourceData
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instance_id, uko_vault, *managedKey.ID))
 
+	if state, ok := d.GetOk("state"); ok && managedKey.State != nil && state.(string) != *managedKey.State {
+		_, err = transitionManagedKeyState(context, ukoClient, uko_vault, *managedKey.ID, response.Headers.Get("Etag"), *managedKey.State, state.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ResourceIbmManagedKeyRead(context, d, meta)
 }
 
@@ -578,56 +600,16 @@ func ResourceIbmManagedKeyUpdate(context context.Context, d *schema.ResourceData
 	// Support for changing state
 	if d.HasChange("state") {
 		prevIntf, newIntf := d.GetChange("state")
-		prev := prevIntf.(string)
-		new := newIntf.(string)
-		if new == "deactivated" {
-			if prev == "active" {
-				deactivateManagedKeyOptions := &ukov4.DeactivateManagedKeyOptions{}
-
-				deactivateManagedKeyOptions.SetIfMatch(etag)
-				deactivateManagedKeyOptions.SetID(key_id)
-				deactivateManagedKeyOptions.SetUKOVault(vault_id)
-
-				_, response, err := ukoClient.DeactivateManagedKeyWithContext(context, deactivateManagedKeyOptions)
-				if err != nil {
-					log.Printf("[DEBUG] DeactivateManagedKeyWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("DeactivateManagedKeyWithContext failed %s\n%s", err, response))
-				}
-			} else {
-				return diag.FromErr(fmt.Errorf("Deactivate managed key failed: Cannot deactivate key not in active state"))
-			}
-		} else if new == "destroyed" {
-			if prev == "deactivated" {
-				destroyManagedKeyOptions := &ukov4.DestroyManagedKeyOptions{}
-
-				destroyManagedKeyOptions.SetIfMatch(etag)
-				destroyManagedKeyOptions.SetID(key_id)
-				destroyManagedKeyOptions.SetUKOVault(vault_id)
-
-				_, response, err := ukoClient.DestroyManagedKeyWithContext(context, destroyManagedKeyOptions)
-				if err != nil {
-					log.Printf("[DEBUG] DestroyManagedKeyWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("DestroyManagedKeyWithContext failed %s\n%s", err, response))
-				}
-			} else {
-				return diag.FromErr(fmt.Errorf("Destroy managed key failed: Cannot destroy key not in deactivated state"))
-			}
-		} else if new == "active" {
-			if prev == "deactivated" || prev == "pre_activation" {
-				activateManagedKeyOptions := &ukov4.ActivateManagedKeyOptions{}
-
-				activateManagedKeyOptions.SetIfMatch(etag)
-				activateManagedKeyOptions.SetID(key_id)
-				activateManagedKeyOptions.SetUKOVault(vault_id)
-
-				_, response, err := ukoClient.ActivateManagedKeyWithContext(context, activateManagedKeyOptions)
-				if err != nil {
-					log.Printf("[DEBUG] ActivateManagedKeyWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("ActivateManagedKeyWithContext failed %s\n%s", err, response))
-				}
-			} else {
-				return diag.FromErr(fmt.Errorf("Activate managed key failed: Cannot activate key not in deactivated or pre_activation state"))
-			}
+		_, err = transitionManagedKeyState(context, ukoClient, vault_id, key_id, etag, prevIntf.(string), newIntf.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("sync_trigger") {
+		_, err = syncManagedKey(context, ukoClient, vault_id, key_id)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -677,6 +659,187 @@ func ResourceIbmManagedKeyDelete(context context.Context, d *schema.ResourceData
 	return nil
 }
 
+// managedKeyStates are the states of a managed key in the order of its lifecycle
+var managedKeyStates = []string{"pre_activation", "active", "deactivated", "destroyed"}
+
+func managedKeyStateIndex(state string) int {
+	for i, s := range managedKeyStates {
+		if s == state {
+			return i
+		}
+	}
+	return -1
+}
+
+// ResourceIbmManagedKeyCustomizeDiff rejects state changes the key lifecycle does not allow during plan
+func ResourceIbmManagedKeyCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return managedKeyInitialStateCustomizeDiff(context, diff, meta)
+	}
+	if !diff.HasChange("state") {
+		return nil
+	}
+	prevIntf, newIntf := diff.GetChange("state")
+	prev, new := prevIntf.(string), newIntf.(string)
+	if new == "" {
+		return nil
+	}
+	if prev == "destroyed" {
+		return fmt.Errorf("Cannot change the state of managed key %s to %s: a destroyed key cannot change its state", diff.Id(), new)
+	}
+	if new == "pre_activation" {
+		return fmt.Errorf("Cannot change the state of managed key %s to pre_activation: the key is already %s", diff.Id(), prev)
+	}
+	return nil
+}
+
+// managedKeyInitialStateCustomizeDiff rejects a state a new key can not reach from the state its template creates it in,
+// as the key already exists when the transition fails
+func managedKeyInitialStateCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.GetRawConfig().GetAttr("state").IsNull() || !diff.NewValueKnown("state") {
+		return nil
+	}
+	state := diff.Get("state").(string)
+	if state == "destroyed" {
+		return fmt.Errorf("Cannot create managed key %s in the destroyed state", diff.Get("label").(string))
+	}
+	for _, key := range []string{"instance_id", "region", "template_name", "vault"} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	ukoClient, err := meta.(conns.ClientSession).UkoV4()
+	if err != nil {
+		return err
+	}
+	url, err := getUkoUrl(context, diff.Get("region").(string), diff.Get("instance_id").(string), ukoClient)
+	if err != nil {
+		return err
+	}
+	ukoClient.SetServiceURL(url)
+
+	templateName := diff.Get("template_name").(string)
+	listKeyTemplatesOptions := &ukov4.ListKeyTemplatesOptions{}
+	listKeyTemplatesOptions.SetVaultID(diff.Get("vault.0.id").(string))
+	listKeyTemplatesOptions.SetLimit(100)
+	for offset := int64(0); ; offset += 100 {
+		listKeyTemplatesOptions.SetOffset(offset)
+		templateList, response, err := ukoClient.ListKeyTemplatesWithContext(context, listKeyTemplatesOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListKeyTemplatesWithContext failed %s\n%s", err, response)
+			return fmt.Errorf("ListKeyTemplatesWithContext failed %s\n%s", err, response)
+		}
+		for _, template := range templateList.Templates {
+			if template.Name == nil || *template.Name != templateName || template.Key == nil || template.Key.State == nil {
+				continue
+			}
+			initialState := *template.Key.State
+			if managedKeyStateIndex(state) < managedKeyStateIndex(initialState) {
+				return fmt.Errorf("Cannot create managed key %s in the %s state: template %s creates keys in the %s state", diff.Get("label").(string), state, templateName, initialState)
+			}
+			return nil
+		}
+		if len(templateList.Templates) == 0 || templateList.TotalCount == nil || offset+int64(len(templateList.Templates)) >= *templateList.TotalCount {
+			return nil
+		}
+	}
+}
+
+// transitionManagedKeyState moves a managed key from its state to the target state, going through the intermediate
+// states of the key lifecycle. It returns the etag of the key after the last transition.
+func transitionManagedKeyState(context context.Context, ukoClient *ukov4.UkoV4, vault_id, key_id, etag, from, to string) (string, error) {
+	fromIndex, toIndex := managedKeyStateIndex(from), managedKeyStateIndex(to)
+	if fromIndex < 0 || toIndex < 0 {
+		return etag, fmt.Errorf("Change managed key state failed: Cannot change the state from %s to %s", from, to)
+	}
+	if from == "deactivated" && to == "active" {
+		// a deactivated key is the only key that goes back in its lifecycle
+		fromIndex = 0
+	} else if toIndex < fromIndex {
+		return etag, fmt.Errorf("Change managed key state failed: Cannot change the state from %s to %s", from, to)
+	}
+
+	for i := fromIndex + 1; i <= toIndex; i++ {
+		var response *core.DetailedResponse
+		var err error
+		switch managedKeyStates[i] {
+		case "active":
+			activateManagedKeyOptions := &ukov4.ActivateManagedKeyOptions{}
+			activateManagedKeyOptions.SetIfMatch(etag)
+			activateManagedKeyOptions.SetID(key_id)
+			activateManagedKeyOptions.SetUKOVault(vault_id)
+
+			_, response, err = ukoClient.ActivateManagedKeyWithContext(context, activateManagedKeyOptions)
+			if err != nil {
+				log.Printf("[DEBUG] ActivateManagedKeyWithContext failed %s\n%s", err, response)
+				return etag, fmt.Errorf("ActivateManagedKeyWithContext failed %s\n%s", err, response)
+			}
+		case "deactivated":
+			deactivateManagedKeyOptions := &ukov4.DeactivateManagedKeyOptions{}
+			deactivateManagedKeyOptions.SetIfMatch(etag)
+			deactivateManagedKeyOptions.SetID(key_id)
+			deactivateManagedKeyOptions.SetUKOVault(vault_id)
+
+			_, response, err = ukoClient.DeactivateManagedKeyWithContext(context, deactivateManagedKeyOptions)
+			if err != nil {
+				log.Printf("[DEBUG] DeactivateManagedKeyWithContext failed %s\n%s", err, response)
+				return etag, fmt.Errorf("DeactivateManagedKeyWithContext failed %s\n%s", err, response)
+			}
+		case "destroyed":
+			destroyManagedKeyOptions := &ukov4.DestroyManagedKeyOptions{}
+			destroyManagedKeyOptions.SetIfMatch(etag)
+			destroyManagedKeyOptions.SetID(key_id)
+			destroyManagedKeyOptions.SetUKOVault(vault_id)
+
+			_, response, err = ukoClient.DestroyManagedKeyWithContext(context, destroyManagedKeyOptions)
+			if err != nil {
+				log.Printf("[DEBUG] DestroyManagedKeyWithContext failed %s\n%s", err, response)
+				return etag, fmt.Errorf("DestroyManagedKeyWithContext failed %s\n%s", err, response)
+			}
+		}
+		etag = response.Headers.Get("Etag")
+	}
+	return etag, nil
+}
+
+// syncManagedKey syncs a managed key to the keystores of its keystore group, which the UKO SDK does not provide
+func syncManagedKey(context context.Context, ukoClient *ukov4.UkoV4, vault_id, key_id string) (*ukov4.StatusInKeystores, error) {
+	pathParamsMap := map[string]string{
+		"id": key_id,
+	}
+
+	builder := core.NewRequestBuilder(core.POST)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = ukoClient.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(ukoClient.Service.Options.URL, `/api/v4/managed_keys/{id}/sync_status_in_keystores`, pathParamsMap)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("UKO-Vault", vault_id)
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err := ukoClient.Service.Request(request, &rawResponse)
+	if err != nil {
+		log.Printf("[DEBUG] Sync managed key failed %s\n%s", err, response)
+		return nil, fmt.Errorf("Sync managed key failed %s\n%s", err, response)
+	}
+	var result *ukov4.StatusInKeystores
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, ukov4.UnmarshalStatusInKeystores)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
 func ResourceIbmManagedKeyMapToVaultReferenceInCreationRequest(modelMap map[string]interface{}) (*ukov4.VaultReferenceInCreationRequest, error) {
 	model := &ukov4.VaultReferenceInCreationRequest{}
 	model.ID = core.StringPtr(modelMap["id"].(string))
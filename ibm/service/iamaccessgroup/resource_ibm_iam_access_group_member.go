@@ -0,0 +1,212 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamaccessgroup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	accessGroupMemberServiceIDPrefix = "iam-ServiceId-"
+	accessGroupMemberProfilePrefix   = "iam-Profile-"
+)
+
+func ResourceIBMIAMAccessGroupMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMAccessGroupMemberCreate,
+		ReadContext:   resourceIBMIAMAccessGroupMemberRead,
+		DeleteContext: resourceIBMIAMAccessGroupMemberDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"access_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of the access group",
+				ForceNew:    true,
+			},
+
+			"ibm_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"ibm_id", "iam_service_id", "iam_profile_id"},
+				Description:  "The IBMid of the user",
+			},
+
+			"iam_service_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"ibm_id", "iam_service_id", "iam_profile_id"},
+				Description:  "The ID of the service ID",
+			},
+
+			"iam_profile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"ibm_id", "iam_service_id", "iam_profile_id"},
+				Description:  "The ID of the trusted profile",
+			},
+
+			"iam_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IAM ID of the member",
+			},
+
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the member: user, service or profile",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMAccessGroupMemberCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	grpID := d.Get("access_group_id").(string)
+
+	var userids, serviceids, profileids []string
+	if user, ok := d.GetOk("ibm_id"); ok {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		userids, err = flex.FlattenUserIds(userDetails.UserAccount, []string{user.(string)}, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if service, ok := d.GetOk("iam_service_id"); ok {
+		serviceids, err = FlattenServiceIds([]string{service.(string)}, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if profile, ok := d.GetOk("iam_profile_id"); ok {
+		profileids, err = FlattenProfileIds([]string{profile.(string)}, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	members := prepareMemberAddRequest(iamAccessGroupsClient, userids, serviceids, profileids)
+
+	addMembersToAccessGroupOptions := iamAccessGroupsClient.NewAddMembersToAccessGroupOptions(grpID)
+	addMembersToAccessGroupOptions.SetMembers(members)
+	membership, detailResponse, err := iamAccessGroupsClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
+	if err != nil || membership == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error adding member to group(%s). API response: %s", grpID, detailResponse))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", grpID, *members[0].IamID))
+
+	return resourceIBMIAMAccessGroupMemberRead(context, d, meta)
+}
+
+func resourceIBMIAMAccessGroupMemberRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) < 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of accessGroupID/iamID", d.Id()))
+	}
+
+	grpID := parts[0]
+	iamID := parts[1]
+	isMemberOfAccessGroupOptions := iamAccessGroupsClient.NewIsMemberOfAccessGroupOptions(grpID, iamID)
+	detailedResponse, err := iamAccessGroupsClient.IsMemberOfAccessGroup(isMemberOfAccessGroupOptions)
+	if err != nil {
+		if detailedResponse != nil && detailedResponse.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group member: %s. API Response: %s", err, detailedResponse))
+	}
+
+	d.Set("access_group_id", grpID)
+	d.Set("iam_id", iamID)
+
+	// The ID of service IDs and trusted profiles is their IAM ID without the iam- prefix
+	switch {
+	case strings.HasPrefix(iamID, accessGroupMemberServiceIDPrefix):
+		d.Set("type", "service")
+		d.Set("iam_service_id", strings.TrimPrefix(iamID, "iam-"))
+	case strings.HasPrefix(iamID, accessGroupMemberProfilePrefix):
+		d.Set("type", "profile")
+		d.Set("iam_profile_id", strings.TrimPrefix(iamID, "iam-"))
+	default:
+		d.Set("type", "user")
+		if d.Get("ibm_id").(string) == "" {
+			userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			users, err := userManagement.UserInvite().ListUsers(userDetails.UserAccount)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			for _, user := range users {
+				if user.IamID == iamID {
+					d.Set("ibm_id", user.Email)
+					break
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMIAMAccessGroupMemberDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) < 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of accessGroupID/iamID", d.Id()))
+	}
+
+	grpID := parts[0]
+	removeMemberFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, parts[1])
+	detailResponse, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMemberFromAccessGroupOptions)
+	if err != nil && (detailResponse == nil || detailResponse.StatusCode != 404) {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error removing member from group(%s). API Response: %s", grpID, detailResponse))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamaccessgroup_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMAccessGroupSingleMember_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	sname := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupSingleMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupSingleMemberBasic(name, sname),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_member.user", "type", "user"),
+					resource.TestCheckResourceAttrSet("ibm_iam_access_group_member.user", "iam_id"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_member.service", "type", "service"),
+					resource.TestCheckResourceAttrPair("ibm_iam_access_group_member.service", "iam_id", "ibm_iam_service_id.serviceID", "iam_id"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_access_group_member.service",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccessGroupSingleMemberDestroy(s *terraform.State) error {
+	accClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_access_group_member" {
+			continue
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		isMemberOfAccessGroupOptions := accClient.NewIsMemberOfAccessGroupOptions(parts[0], parts[1])
+		detailResponse, err := accClient.IsMemberOfAccessGroup(isMemberOfAccessGroupOptions)
+		if err == nil {
+			return fmt.Errorf("Access group member still exists: %s", rs.Primary.ID)
+		} else if detailResponse.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error waiting for access group member (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMAccessGroupSingleMemberBasic(name, sname string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_iam_access_group" "accgroup" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_service_id" "serviceID" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_access_group_member" "user" {
+		access_group_id = ibm_iam_access_group.accgroup.id
+		ibm_id          = "%s"
+	}
+
+	resource "ibm_iam_access_group_member" "service" {
+		access_group_id = ibm_iam_access_group.accgroup.id
+		iam_service_id  = ibm_iam_service_id.serviceID.id
+	}`, name, sname, acc.IAMUser)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -24,7 +25,9 @@ func ResourceIBMIAMAccessGroupMembers() *schema.Resource {
 		ReadContext:   resourceIBMIAMAccessGroupMembersRead,
 		UpdateContext: resourceIBMIAMAccessGroupMembersUpdate,
 		DeleteContext: resourceIBMIAMAccessGroupMembersDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMIAMAccessGroupMembersImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -53,6 +56,13 @@ func ResourceIBMIAMAccessGroupMembers() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"exclusive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the resource manages all members of the access group. Members that are not managed by the resource are shown in the plan and removed from the access group",
+			},
+
 			"members": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -128,7 +138,20 @@ func resourceIBMIAMAccessGroupMembersCreate(context context.Context, d *schema.R
 	return resourceIBMIAMAccessGroupMembersRead(context, d, meta)
 }
 
+func resourceIBMIAMAccessGroupMembersImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if diags := readIBMIAMAccessGroupMembers(context, d, meta, true); diags.HasError() {
+		return nil, fmt.Errorf("[ERROR] Error importing access group members %s: %s", d.Id(), diags[0].Summary)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readIBMIAMAccessGroupMembers(context, d, meta, false)
+}
+
+// readIBMIAMAccessGroupMembers reads the members of the access group; importing reads all members
+// instead of only the ones in the state
+func readIBMIAMAccessGroupMembers(context context.Context, d *schema.ResourceData, meta interface{}, importing bool) diag.Diagnostics {
 	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
 	if err != nil {
 		return diag.FromErr(err)
@@ -233,18 +256,41 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 
 	d.Set("members", flex.FlattenAccessGroupMembers(allMembers, res, allrecs))
 	ibmID, serviceID, profileID := flex.FlattenMembersData(allMembers, res, allrecs, allprofiles)
-	if len(ibmID) > 0 {
+
+	// Without exclusive, only the members managed by the resource are read, so that other resources
+	// and the console can add members to the same access group. All members are read on import.
+	managed := !importing
+	if !d.Get("exclusive").(bool) && managed {
+		ibmID = filterAccessGroupMembers(ibmID, d.Get("ibm_ids").(*schema.Set), true)
+		serviceID = filterAccessGroupMembers(serviceID, d.Get("iam_service_ids").(*schema.Set), false)
+		profileID = filterAccessGroupMembers(profileID, d.Get("iam_profile_ids").(*schema.Set), false)
+	}
+	if managed || len(ibmID) > 0 {
 		d.Set("ibm_ids", ibmID)
 	}
-	if len(serviceID) > 0 {
+	if managed || len(serviceID) > 0 {
 		d.Set("iam_service_ids", serviceID)
 	}
-	if len(profileID) > 0 {
+	if managed || len(profileID) > 0 {
 		d.Set("iam_profile_ids", profileID)
 	}
 	return nil
 }
 
+// filterAccessGroupMembers returns the members of the access group that are in the managed set
+func filterAccessGroupMembers(members []string, managed *schema.Set, ignoreCase bool) []string {
+	result := []string{}
+	for _, member := range members {
+		for _, m := range managed.List() {
+			if member == m.(string) || ignoreCase && strings.EqualFold(member, m.(string)) {
+				result = append(result, m.(string))
+				break
+			}
+		}
+	}
+	return result
+}
+
 func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
 	if err != nil {
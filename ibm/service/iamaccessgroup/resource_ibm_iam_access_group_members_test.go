@@ -107,6 +107,45 @@ func TestAccIBMIAMAccessGroupMember_import(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMAccessGroupMember_Exclusive(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	sname := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupMemberUnmanaged(name, sname, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "exclusive", "false"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "ibm_ids.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "iam_service_ids.#", "0"),
+					testAccCheckIBMIAMAccessGroupMemberAddUnmanaged("ibm_iam_access_group.accgroup", "ibm_iam_service_id.serviceID"),
+				),
+			},
+			{
+				// the member added outside of Terraform is not read without exclusive
+				Config:   testAccCheckIBMIAMAccessGroupMemberUnmanaged(name, sname, false),
+				PlanOnly: true,
+			},
+			{
+				Config:             testAccCheckIBMIAMAccessGroupMemberUnmanaged(name, sname, true),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupMemberUnmanaged(name, sname, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "exclusive", "true"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "ibm_ids.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "members.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIAMAccessGroupMemberDestroy(s *terraform.State) error {
 	accClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMAccessGroupsV2()
 	if err != nil {
@@ -138,6 +177,35 @@ func testAccCheckIBMIAMAccessGroupMemberDestroy(s *terraform.State) error {
 	return nil
 }
 
+// testAccCheckIBMIAMAccessGroupMemberAddUnmanaged adds the service ID to the access group outside of Terraform
+func testAccCheckIBMIAMAccessGroupMemberAddUnmanaged(group, serviceID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		grp, ok := s.RootModule().Resources[group]
+		if !ok {
+			return fmt.Errorf("Not found: %s", group)
+		}
+		sid, ok := s.RootModule().Resources[serviceID]
+		if !ok {
+			return fmt.Errorf("Not found: %s", serviceID)
+		}
+		accClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMAccessGroupsV2()
+		if err != nil {
+			return err
+		}
+		member, err := accClient.NewAddGroupMembersRequestMembersItem(sid.Primary.Attributes["iam_id"], "service")
+		if err != nil {
+			return err
+		}
+		addMembersToAccessGroupOptions := accClient.NewAddMembersToAccessGroupOptions(grp.Primary.ID)
+		addMembersToAccessGroupOptions.SetMembers([]iamaccessgroupsv2.AddGroupMembersRequestMembersItem{*member})
+		_, response, err := accClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error adding unmanaged member to access group: %s\n%s", err, response)
+		}
+		return nil
+	}
+}
+
 func testAccCheckIBMIAMAccessGroupMemberBasic(name string) string {
 	return fmt.Sprintf(`
 		
@@ -301,3 +369,21 @@ func testAccCheckIBMIAMAccessGroupMemberImport(name, sname, pname string) string
 		iam_profile_ids = [ibm_iam_trusted_profile.profileID.id]
 	}`, name, sname, pname, acc.IAMUser)
 }
+
+func testAccCheckIBMIAMAccessGroupMemberUnmanaged(name, sname string, exclusive bool) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_iam_access_group" "accgroup" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_service_id" "serviceID" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_access_group_members" "accgroupmem" {
+		access_group_id = ibm_iam_access_group.accgroup.id
+		ibm_ids         = ["%s"]
+		exclusive       = %t
+	}`, name, sname, acc.IAMUser, exclusive)
+}
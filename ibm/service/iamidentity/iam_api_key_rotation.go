@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// apiKeyRotationSchema adds the arguments that rotate an API key with an overlap window to the schema of an API key resource
+func apiKeyRotationSchema(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	resourceSchema["rotate_trigger"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Any change of the value rotates the API key: a new API key is created and the previous API key is kept for rotation_overlap.",
+	}
+	resourceSchema["rotation_overlap"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validateAPIKeyRotationOverlap,
+		Description:  "The duration the previous API key is kept after a rotation, for example 168h. The previous API key is deleted on the first apply after the duration. Without it the previous API key is deleted on rotation.",
+	}
+	resourceSchema["previous_apikey_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The ID of the previous API key that is kept after a rotation.",
+	}
+	resourceSchema["previous_apikey_expires_at"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The date and time after which the previous API key is deleted.",
+	}
+	return resourceSchema
+}
+
+func validateAPIKeyRotationOverlap(v interface{}, k string) (ws []string, errors []error) {
+	overlap, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration like 24h or 30m: %s", k, err))
+	} else if overlap < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative", k))
+	}
+	return
+}
+
+// apiKeyRotationCustomizeDiff rejects the rotation of a passed through API key and plans the deletion of
+// the previous API key once the overlap window is over.
+func apiKeyRotationCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if diff.HasChange("rotate_trigger") {
+		if !diff.GetRawConfig().GetAttr("apikey").IsNull() {
+			return fmt.Errorf("[ERROR] A passed through apikey cannot be rotated with rotate_trigger")
+		}
+		diff.SetNewComputed("apikey")
+		diff.SetNewComputed("previous_apikey_id")
+		diff.SetNewComputed("previous_apikey_expires_at")
+		return nil
+	}
+	if apiKeyRotationExpired(diff.Get("previous_apikey_id").(string), diff.Get("previous_apikey_expires_at").(string)) {
+		diff.SetNew("previous_apikey_id", "")
+		diff.SetNew("previous_apikey_expires_at", "")
+	}
+	return nil
+}
+
+// apiKeyRotationExpired returns whether there is a previous API key whose overlap window is over
+func apiKeyRotationExpired(previousID, expiresAt string) bool {
+	if previousID == "" {
+		return false
+	}
+	expiry, err := time.Parse(time.RFC3339, expiresAt)
+	return err != nil || !time.Now().Before(expiry)
+}
+
+// rotateAPIKey creates a new API key with createAPIKeyOptions and keeps the current API key as the previous
+// API key for the overlap window. A previous API key that is still kept is deleted.
+func rotateAPIKey(context context.Context, d *schema.ResourceData, meta interface{}, createAPIKeyOptions *iamidentityv1.CreateAPIKeyOptions) error {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	var overlap time.Duration
+	if v, ok := d.GetOk("rotation_overlap"); ok {
+		overlap, err = time.ParseDuration(v.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	o, _ := d.GetChange("previous_apikey_id")
+	if err := deleteAPIKey(context, iamIdentityClient, o.(string)); err != nil {
+		return err
+	}
+
+	apiKey, response, err := iamIdentityClient.CreateAPIKeyWithContext(context, createAPIKeyOptions)
+	if err != nil || apiKey == nil {
+		return fmt.Errorf("[ERROR] Error rotating API Key %s: %s\n%s", d.Id(), err, response)
+	}
+
+	// the current API key is kept as the previous API key before anything can fail, so that it is
+	// still tracked and deleted later if the new API key can not be stored
+	currentID := d.Id()
+	d.SetId(*apiKey.ID)
+	d.Set("apikey", "")
+	d.Set("previous_apikey_id", currentID)
+	d.Set("previous_apikey_expires_at", time.Now().UTC().Add(overlap).Format(time.RFC3339))
+	if err := storeAPIKeyValue(context, d, meta, apiKey); err != nil {
+		return err
+	}
+	if keyfile, ok := d.GetOk("file"); ok {
+		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
+			log.Printf("Error writing API Key Details to file: %s", err)
+		}
+	}
+
+	if overlap == 0 {
+		if err := deleteAPIKey(context, iamIdentityClient, currentID); err != nil {
+			return err
+		}
+		d.Set("previous_apikey_id", "")
+		d.Set("previous_apikey_expires_at", "")
+	}
+	return nil
+}
+
+// updateAPIKeyRotation rotates the API key when rotate_trigger changed and deletes the previous API key
+// once the overlap window is over.
+func updateAPIKeyRotation(context context.Context, d *schema.ResourceData, meta interface{}, createAPIKeyOptions *iamidentityv1.CreateAPIKeyOptions) error {
+	if d.HasChange("rotate_trigger") {
+		return rotateAPIKey(context, d, meta, createAPIKeyOptions)
+	}
+	o, n := d.GetChange("previous_apikey_id")
+	if o.(string) != "" && n.(string) == "" {
+		iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return err
+		}
+		if err := deleteAPIKey(context, iamIdentityClient, o.(string)); err != nil {
+			expiresAt, _ := d.GetChange("previous_apikey_expires_at")
+			d.Set("previous_apikey_id", o)
+			d.Set("previous_apikey_expires_at", expiresAt)
+			return err
+		}
+		d.Set("previous_apikey_expires_at", "")
+	}
+	return nil
+}
+
+// deleteAPIKey deletes an API key that may already be deleted
+func deleteAPIKey(context context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, apiKeyID string) error {
+	if apiKeyID == "" {
+		return nil
+	}
+	deleteAPIKeyOptions := &iamidentityv1.DeleteAPIKeyOptions{
+		ID: &apiKeyID,
+	}
+	response, err := iamIdentityClient.DeleteAPIKeyWithContext(context, deleteAPIKeyOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error deleting API Key %s: %s\n%s", apiKeyID, err, response)
+	}
+	return nil
+}
@@ -4,12 +4,17 @@
 package iamidentity
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
+	"net"
+	"strconv"
+	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	jwt "github.com/golang-jwt/jwt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -30,6 +35,7 @@ func ResourceIBMIAMAccountSettings() *schema.Resource {
 		UpdateContext: resourceIbmIamAccountSettingsUpdate,
 		DeleteContext: resourceIbmIamAccountSettingsDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIbmIamAccountSettingsLockoutCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"include_history": {
@@ -53,9 +59,16 @@ func ResourceIBMIAMAccountSettings() *schema.Resource {
 				Description:  "Defines whether or not creating platform API keys is access controlled. Valid values:  * RESTRICTED - to apply access control  * NOT_RESTRICTED - to remove access control  * NOT_SET - to 'unset' a previous set value.",
 			},
 			"allowed_ip_addresses": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Defines the IP addresses and subnets from which IAM tokens can be created for the account.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateIPorCIDRList,
+				Description:  "Defines the IP addresses and subnets from which IAM tokens can be created for the account. A comma separated list of IP addresses, CIDR addresses and IP address ranges like 10.0.0.1-10.0.0.10.",
+			},
+			"caller_ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateIP,
+				Description:  "The IP address the caller creates IAM tokens from, which overrides the IP address of the IAM token of the provider. The plan fails when allowed_ip_addresses would not allow it, so that the caller is not locked out of the account. The value is not sent to IAM.",
 			},
 			"entity_tag": {
 				Type:        schema.TypeString,
@@ -117,22 +130,25 @@ func ResourceIBMIAMAccountSettings() *schema.Resource {
 				},
 			},
 			"session_expiration_in_seconds": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Defines the session expiration in seconds for the account. Valid values:  * Any whole number between between '900' and '86400'  * NOT_SET - To unset account setting and use service default.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAccountSettingsNumber(900, 86400),
+				Description:  "Defines the session expiration in seconds for the account. Valid values:  * Any whole number between between '900' and '86400'  * NOT_SET - To unset account setting and use service default.",
 			},
 			"session_invalidation_in_seconds": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Defines the period of time in seconds in which a session will be invalidated due  to inactivity. Valid values:   * Any whole number between '900' and '7200'   * NOT_SET - To unset account setting and use service default.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAccountSettingsNumber(900, 7200),
+				Description:  "Defines the period of time in seconds in which a session will be invalidated due  to inactivity. Valid values:   * Any whole number between '900' and '7200'   * NOT_SET - To unset account setting and use service default.",
 			},
 			"max_sessions_per_identity": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Defines the max allowed sessions per identity required by the account. Value values: * Any whole number greater than '0'   * NOT_SET - To unset account setting and use service default.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAccountSettingsNumber(1, 0),
+				Description:  "Defines the max allowed sessions per identity required by the account. Value values: * Any whole number greater than '0'   * NOT_SET - To unset account setting and use service default.",
 			},
 		},
 	}
@@ -170,6 +186,94 @@ func ResourceIBMIAMAccountSettingsValidator() *validate.ResourceValidator {
 	return &ibmIAMAccountSettingsValidator
 }
 
+// validateAccountSettingsNumber validates a whole number of an account setting between min and max, or NOT_SET.
+// A max of 0 means there is no upper bound.
+func validateAccountSettingsNumber(min, max int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		if value == "NOT_SET" {
+			return
+		}
+		number, err := strconv.Atoi(value)
+		if err != nil || number < min || max > 0 && number > max {
+			if max > 0 {
+				errors = append(errors, fmt.Errorf("%q must be a whole number between %d and %d or NOT_SET, got %q", k, min, max, value))
+			} else {
+				errors = append(errors, fmt.Errorf("%q must be a whole number of at least %d or NOT_SET, got %q", k, min, value))
+			}
+		}
+		return
+	}
+}
+
+// resourceIbmIamAccountSettingsLockoutCustomizeDiff fails the plan when the new allowed IP addresses
+// would not allow the caller IP address to create IAM tokens. The caller IP address is caller_ip_address,
+// or the IP address of the IAM token of the provider. When it cannot be determined only a warning is logged.
+func resourceIbmIamAccountSettingsLockoutCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	allowedIPAddresses := diff.Get("allowed_ip_addresses").(string)
+	if strings.TrimSpace(allowedIPAddresses) == "" {
+		return nil
+	}
+	if !diff.HasChange("allowed_ip_addresses") && !diff.HasChange("caller_ip_address") {
+		return nil
+	}
+	callerIP := net.ParseIP(diff.Get("caller_ip_address").(string))
+	if callerIP == nil {
+		callerIP = tokenIPAddress(meta)
+	}
+	if callerIP == nil {
+		log.Printf("[WARN] The caller IP address could not be determined, allowed_ip_addresses %q is not checked against it. Set caller_ip_address so that the caller is not locked out of the account", allowedIPAddresses)
+		return nil
+	}
+	if !ipAddressesContain(allowedIPAddresses, callerIP) {
+		return fmt.Errorf("[ERROR] allowed_ip_addresses does not contain the caller IP address %s, the caller would be locked out of the account", callerIP)
+	}
+	return nil
+}
+
+// tokenIPAddress returns the IP address the IAM token of the provider was created from, or nil when the token does not contain it
+func tokenIPAddress(meta interface{}) net.IP {
+	session, ok := meta.(conns.ClientSession)
+	if !ok {
+		return nil
+	}
+	bmxSess, err := session.BluemixSession()
+	if err != nil {
+		return nil
+	}
+	claims := jwt.MapClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(strings.TrimPrefix(bmxSess.Config.IAMAccessToken, "Bearer "), claims); err != nil {
+		log.Printf("[DEBUG] Error parsing the IAM token of the provider: %s", err)
+		return nil
+	}
+	ip, _ := claims["ip"].(string)
+	return net.ParseIP(ip)
+}
+
+// ipAddressesContain returns whether a comma separated list of IP addresses, CIDR addresses and IP address ranges contains ip
+func ipAddressesContain(ipAddresses string, ip net.IP) bool {
+	for _, entry := range strings.Split(ipAddresses, ",") {
+		entry = strings.TrimSpace(entry)
+		if bounds := strings.Split(entry, "-"); len(bounds) == 2 {
+			start, end := net.ParseIP(strings.TrimSpace(bounds[0])), net.ParseIP(strings.TrimSpace(bounds[1]))
+			if start != nil && end != nil && bytes.Compare(ip.To16(), start.To16()) >= 0 && bytes.Compare(ip.To16(), end.To16()) <= 0 {
+				return true
+			}
+			continue
+		}
+		if _, ipNet, err := net.ParseCIDR(entry); err == nil {
+			if ipNet.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		if ip.Equal(net.ParseIP(entry)) {
+			return true
+		}
+	}
+	return false
+}
+
 func resourceIbmIamAccountSettingsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
 	if err != nil {
@@ -5,6 +5,7 @@ package iamidentity_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -101,6 +102,30 @@ func TestAccIBMIAMAccountSettingsUpdate(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMAccountSettingsAllowedIPLockout(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIbmIamAccountSettingsAllowedIPConfig("10.0.0.0/24,10.1.0.1-10.1.0.10", "192.168.0.1"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("the caller would be locked out of the account"),
+			},
+			{
+				Config:      testAccCheckIbmIamAccountSettingsAllowedIPConfig("10.0.0.0/24,10.0.0.300", "10.0.0.1"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("not a valid ip address, cidr address or ip address range"),
+			},
+			{
+				Config:      testAccCheckIbmIamAccountSettingsAllowedIPConfig("10.0.0.0/24,10.1.0.10-10.1.0.1", "10.0.0.1"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("not a valid ip address range"),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmIamAccountSettingsConfigBasic() string {
 	return `
 
@@ -169,3 +194,13 @@ func testAccCheckIbmIamAccountSettingsExists(n string, obj iamidentityv1.Account
 		return nil
 	}
 }
+
+func testAccCheckIbmIamAccountSettingsAllowedIPConfig(allowedIPAddresses, callerIPAddress string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_account_settings" "iam_account_settings" {
+			allowed_ip_addresses = "%s"
+			caller_ip_address    = "%s"
+		}
+	`, allowedIPAddresses, callerIPAddress)
+}
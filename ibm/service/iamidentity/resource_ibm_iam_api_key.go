@@ -10,6 +10,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -23,8 +24,9 @@ func ResourceIBMIAMApiKey() *schema.Resource {
 		UpdateContext: resourceIbmIamApiKeyUpdate,
 		DeleteContext: resourceIbmIamApiKeyDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: apiKeyRotationCustomizeDiff,
 
-		Schema: map[string]*schema.Schema{
+		Schema: secretsmanager.CredentialsSinkSchema(apiKeyRotationSchema(map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -46,11 +48,12 @@ func ResourceIBMIAMApiKey() *schema.Resource {
 				Description: "The account ID of the API key.",
 			},
 			"apikey": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Sensitive:   true,
-				Description: "You can optionally passthrough the API key value for this API key. If passed, NO validation of that apiKey value is done, i.e. the value can be non-URL safe. If omitted, the API key management will create an URL safe opaque API key value. The value of the API key is checked for uniqueness. Please ensure enough variations when passing in this value.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: flex.SuppressSaltedHashedSecret,
+				Description:      "You can optionally passthrough the API key value for this API key. If passed, NO validation of that apiKey value is done, i.e. the value can be non-URL safe. If omitted, the API key management will create an URL safe opaque API key value. The value of the API key is checked for uniqueness. Please ensure enough variations when passing in this value. A passed through value is not stored in state, only its hash.",
 			},
 			"store_value": {
 				Type:        schema.TypeBool,
@@ -61,6 +64,7 @@ func ResourceIBMIAMApiKey() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				DiffSuppressFunc: flex.ApplyOnce,
+				Deprecated:       "file keeps the API key in state, use credentials_output_path instead",
 				Description:      "File where api key is to be stored",
 			},
 			"entity_lock": {
@@ -104,7 +108,7 @@ func ResourceIBMIAMApiKey() *schema.Resource {
 				Computed:    true,
 				Description: "If set contains a date time string of the last modification date in ISO format.",
 			},
-		},
+		})),
 	}
 }
 
@@ -114,28 +118,13 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	createApiKeyOptions := &iamidentityv1.CreateAPIKeyOptions{}
-
-	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	createApiKeyOptions, err := apiKeyCreateOptions(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	iamID := userDetails.UserID
-	accountID := userDetails.UserAccount
-
-	createApiKeyOptions.SetName(d.Get("name").(string))
-	createApiKeyOptions.SetIamID(iamID)
-	createApiKeyOptions.SetAccountID(accountID)
-
-	if _, ok := d.GetOk("description"); ok {
-		createApiKeyOptions.SetDescription(d.Get("description").(string))
-	}
 	if _, ok := d.GetOk("apikey"); ok {
 		createApiKeyOptions.SetApikey(d.Get("apikey").(string))
 	}
-	if _, ok := d.GetOk("store_value"); ok {
-		createApiKeyOptions.SetStoreValue(d.Get("store_value").(bool))
-	}
 	if _, ok := d.GetOk("locked"); ok {
 		createApiKeyOptions.SetEntityLock(d.Get("locked").(string))
 	}
@@ -146,8 +135,14 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	// the ID is only committed once the value is stored, an API key whose value was lost is deleted
+	if err := storeAPIKeyValue(context, d, meta, apiKey); err != nil {
+		if deleteErr := deleteAPIKey(context, iamIdentityClient, *apiKey.ID); deleteErr != nil {
+			log.Printf("[WARN] %s", deleteErr)
+		}
+		return diag.FromErr(err)
+	}
 	d.SetId(*apiKey.ID)
-	d.Set("apikey", *apiKey.Apikey)
 
 	if keyfile, ok := d.GetOk("file"); ok {
 		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
@@ -158,6 +153,28 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 	return resourceIbmIamApiKeyRead(context, d, meta)
 }
 
+// apiKeyCreateOptions returns the options that create the API key of the user, without a passed through API key
+func apiKeyCreateOptions(d *schema.ResourceData, meta interface{}) (*iamidentityv1.CreateAPIKeyOptions, error) {
+	createApiKeyOptions := &iamidentityv1.CreateAPIKeyOptions{}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	createApiKeyOptions.SetName(d.Get("name").(string))
+	createApiKeyOptions.SetIamID(userDetails.UserID)
+	createApiKeyOptions.SetAccountID(userDetails.UserAccount)
+
+	if _, ok := d.GetOk("description"); ok {
+		createApiKeyOptions.SetDescription(d.Get("description").(string))
+	}
+	if _, ok := d.GetOk("store_value"); ok {
+		createApiKeyOptions.SetStoreValue(d.Get("store_value").(bool))
+	}
+	return createApiKeyOptions, nil
+}
+
 func resourceIbmIamApiKeyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
 	if err != nil {
@@ -221,6 +238,14 @@ func resourceIbmIamApiKeyUpdate(context context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	createApiKeyOptions, err := apiKeyCreateOptions(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateAPIKeyRotation(context, d, meta, createApiKeyOptions); err != nil {
+		return diag.FromErr(err)
+	}
+
 	updateApiKeyOptions := &iamidentityv1.UpdateAPIKeyOptions{}
 
 	updateApiKeyOptions.SetIfMatch("*")
@@ -253,6 +278,12 @@ func resourceIbmIamApiKeyDelete(context context.Context, d *schema.ResourceData,
 		log.Printf("[DEBUG] DeleteApiKey failed %s\n%s", err, response)
 		return diag.FromErr(err)
 	}
+	if err := deleteAPIKey(context, iamIdentityClient, d.Get("previous_apikey_id").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := secretsmanager.DeleteCredentialsFromSink(context, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.SetId("")
 
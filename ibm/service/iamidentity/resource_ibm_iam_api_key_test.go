@@ -5,6 +5,9 @@ package iamidentity_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -84,6 +87,119 @@ func TestAccIbmIamApiKeyAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIbmIamApiKeyCredentialsOutputPath(t *testing.T) {
+	name := fmt.Sprintf("name_%d", acctest.RandIntRange(10, 100))
+	outputPath := filepath.Join(t.TempDir(), "apikey.json")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmIamApiKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmIamApiKeyConfigCredentialsOutputPath(name, outputPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("ibm_iam_api_key.iam_api_key", "apikey", regexp.MustCompile("^hash:SHA3-512:")),
+					func(s *terraform.State) error {
+						_, err := os.Stat(outputPath)
+						return err
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccIbmIamApiKeyRotation(t *testing.T) {
+	var apiKeyID string
+	name := fmt.Sprintf("name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmIamApiKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmIamApiKeyConfigRotation(name, "1", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_api_key.iam_api_key", "previous_apikey_id", ""),
+					testAccCheckIbmIamApiKeyRotated("ibm_iam_api_key.iam_api_key", &apiKeyID),
+				),
+			},
+			{
+				// without rotation_overlap the previous API key is deleted during the rotation
+				Config: testAccCheckIbmIamApiKeyConfigRotation(name, "2", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_api_key.iam_api_key", "previous_apikey_id", ""),
+					resource.TestCheckResourceAttr("ibm_iam_api_key.iam_api_key", "previous_apikey_expires_at", ""),
+					testAccCheckIbmIamApiKeyRotated("ibm_iam_api_key.iam_api_key", &apiKeyID),
+				),
+			},
+			{
+				Config: testAccCheckIbmIamApiKeyConfigRotation(name, "3", "24h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("ibm_iam_api_key.iam_api_key", "previous_apikey_id", &apiKeyID),
+					resource.TestCheckResourceAttrSet("ibm_iam_api_key.iam_api_key", "previous_apikey_expires_at"),
+					resource.TestCheckResourceAttrSet("ibm_iam_api_key.iam_api_key", "apikey"),
+					testAccCheckIbmIamApiKeyRotated("ibm_iam_api_key.iam_api_key", &apiKeyID),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckIbmIamApiKeyRotated checks that the API key exists and differs from apiKeyID, which is set
+// to the ID of the API key
+func testAccCheckIbmIamApiKeyRotated(n string, apiKeyID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == *apiKeyID {
+			return fmt.Errorf("API Key %s was not rotated", rs.Primary.ID)
+		}
+
+		iamIdentityClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return err
+		}
+		getApiKeyOptions := &iamidentityv1.GetAPIKeyOptions{}
+		getApiKeyOptions.SetID(rs.Primary.ID)
+		if _, _, err := iamIdentityClient.GetAPIKey(getApiKeyOptions); err != nil {
+			return err
+		}
+
+		*apiKeyID = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckIbmIamApiKeyConfigRotation(name, rotateTrigger, rotationOverlap string) string {
+	overlap := ""
+	if rotationOverlap != "" {
+		overlap = fmt.Sprintf("rotation_overlap = \"%s\"", rotationOverlap)
+	}
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_api_key" "iam_api_key" {
+			name           = "%s"
+			rotate_trigger = "%s"
+			%s
+		}
+	`, name, rotateTrigger, overlap)
+}
+
+func testAccCheckIbmIamApiKeyConfigCredentialsOutputPath(name, outputPath string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_api_key" "iam_api_key" {
+			name                    = "%s"
+			credentials_output_path = "%s"
+		}
+	`, name, outputPath)
+}
+
 func testAccCheckIbmIamApiKeyConfigBasic(name string) string {
 	return fmt.Sprintf(`
 
@@ -4,6 +4,7 @@
 package iamidentity
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -12,7 +13,9 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
 )
@@ -26,7 +29,12 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 		Exists:   resourceIBMIAMServiceAPIKeyExists,
 		Importer: &schema.ResourceImporter{},
 
-		Schema: map[string]*schema.Schema{
+		CustomizeDiff: customdiff.Sequence(
+			apiKeyRotationCustomizeDiff,
+			resourceIBMIAMServiceAPIKeyValueCustomizeDiff,
+		),
+
+		Schema: secretsmanager.CredentialsSinkSchema(apiKeyRotationSchema(map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -54,12 +62,12 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 			},
 
 			"apikey": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Sensitive:   true,
-				ForceNew:    true,
-				Description: "API key value for this API key",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: flex.SuppressSaltedHashedSecret,
+				Description:      "API key value for this API key. A passed through value is not stored in state, only its hash.",
 			},
 
 			"locked": {
@@ -80,6 +88,7 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				DiffSuppressFunc: flex.ApplyOnce,
+				Deprecated:       "file keeps the API key in state, use credentials_output_path instead",
 				Description:      "File where api key is to be stored",
 			},
 
@@ -112,8 +121,16 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 				Computed:    true,
 				Description: "The date and time Service API Key was modified",
 			},
-		},
+		})),
+	}
+}
+
+// resourceIBMIAMServiceAPIKeyValueCustomizeDiff replaces the API key when the passed through API key changes
+func resourceIBMIAMServiceAPIKeyValueCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange("rotate_trigger") && diff.HasChange("apikey") {
+		return diff.ForceNew("apikey")
 	}
+	return nil
 }
 
 type APIKey struct {
@@ -130,6 +147,41 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	createAPIKeyOptions, err := serviceAPIKeyCreateOptions(d, meta)
+	if err != nil {
+		return err
+	}
+
+	if key, ok := d.GetOk("apikey"); ok {
+		apikeyString := key.(string)
+		createAPIKeyOptions.Apikey = &apikeyString
+	}
+
+	apiKey, response, err := iamIdentityClient.CreateAPIKey(createAPIKeyOptions)
+	if err != nil || apiKey == nil {
+		return fmt.Errorf("[DEBUG] Service API Key creation Error: %s\n%s", err, response)
+	}
+
+	// the ID is only committed once the value is stored, an API key whose value was lost is deleted
+	if err := storeAPIKeyValue(context.Background(), d, meta, apiKey); err != nil {
+		if deleteErr := deleteAPIKey(context.Background(), iamIdentityClient, *apiKey.ID); deleteErr != nil {
+			log.Printf("[WARN] %s", deleteErr)
+		}
+		return err
+	}
+	d.SetId(*apiKey.ID)
+
+	if keyfile, ok := d.GetOk("file"); ok {
+		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
+			log.Printf("Error writing API Key Details to file: %s", err)
+		}
+	}
+
+	return resourceIBMIAMServiceAPIKeyRead(d, meta)
+}
+
+// serviceAPIKeyCreateOptions returns the options that create the service API key, without a passed through API key
+func serviceAPIKeyCreateOptions(d *schema.ResourceData, meta interface{}) (*iamidentityv1.CreateAPIKeyOptions, error) {
 	name := d.Get("name").(string)
 	iamID := d.Get("iam_service_id").(string)
 
@@ -145,15 +197,10 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	createAPIKeyOptions.AccountID = &userDetails.UserAccount
 
-	if key, ok := d.GetOk("apikey"); ok {
-		apikeyString := key.(string)
-		createAPIKeyOptions.Apikey = &apikeyString
-	}
-
 	if strvalue, ok := d.GetOk("store_value"); ok {
 		value := strvalue.(bool)
 		createAPIKeyOptions.StoreValue = &value
@@ -163,22 +210,7 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 		elockstr := strconv.FormatBool(lock.(bool))
 		createAPIKeyOptions.EntityLock = &elockstr
 	}
-
-	apiKey, response, err := iamIdentityClient.CreateAPIKey(createAPIKeyOptions)
-	if err != nil || apiKey == nil {
-		return fmt.Errorf("[DEBUG] Service API Key creation Error: %s\n%s", err, response)
-	}
-
-	d.SetId(*apiKey.ID)
-	d.Set("apikey", *apiKey.Apikey)
-
-	if keyfile, ok := d.GetOk("file"); ok {
-		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
-			log.Printf("Error writing API Key Details to file: %s", err)
-		}
-	}
-
-	return resourceIBMIAMServiceAPIKeyRead(d, meta)
+	return createAPIKeyOptions, nil
 }
 
 func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
@@ -212,8 +244,10 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 	if apiKey.AccountID != nil {
 		d.Set("account_id", *apiKey.AccountID)
 	}
-	if *apiKey.Apikey != "" {
-		d.Set("apikey", *apiKey.Apikey)
+	if apiKey.Apikey != nil {
+		if err = setAPIKeyValue(d, *apiKey.Apikey); err != nil {
+			return err
+		}
 	}
 	if apiKey.CRN != nil {
 		d.Set("crn", *apiKey.CRN)
@@ -243,6 +277,14 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
+
+	createAPIKeyOptions, err := serviceAPIKeyCreateOptions(d, meta)
+	if err != nil {
+		return err
+	}
+	if err := updateAPIKeyRotation(context.Background(), d, meta, createAPIKeyOptions); err != nil {
+		return err
+	}
 	apiKeyID := d.Id()
 
 	getAPIKeyOptions := &iamidentityv1.GetAPIKeyOptions{
@@ -298,7 +340,7 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
-			return nil
+			return deleteAPIKey(context.Background(), iamIdentityClient, d.Get("previous_apikey_id").(string))
 		}
 		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response)
 	}
@@ -311,6 +353,12 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Error deleting Service API Key: %s\n%s", err, resp)
 	}
+	if err := deleteAPIKey(context.Background(), iamIdentityClient, d.Get("previous_apikey_id").(string)); err != nil {
+		return err
+	}
+	if err := secretsmanager.DeleteCredentialsFromSink(context.Background(), d, meta); err != nil {
+		return err
+	}
 	d.SetId("")
 
 	return nil
@@ -343,6 +391,16 @@ func saveToFile(apiKey *iamidentityv1.APIKey, filePath string) error {
 		return fmt.Errorf("[ERROR] Error generating API Key file path: %s", err)
 	}
 
+	err = ioutil.WriteFile(outputFilePath, apiKeyDetails(apiKey), 0666)
+	if err == nil {
+		log.Println("Successfully save API key information to ", outputFilePath)
+	}
+
+	return err
+}
+
+// apiKeyDetails returns the details of an API key written to a file or to a credentials sink
+func apiKeyDetails(apiKey *iamidentityv1.APIKey) []byte {
 	key := &APIKey{
 		Name:      *apiKey.Name,
 		Apikey:    *apiKey.Apikey,
@@ -356,11 +414,40 @@ func saveToFile(apiKey *iamidentityv1.APIKey, filePath string) error {
 	}
 
 	out, _ := json.MarshalIndent(key, "", "\t")
+	return out
+}
 
-	err = ioutil.WriteFile(outputFilePath, out, 0666)
-	if err == nil {
-		log.Println("Successfully save API key information to ", outputFilePath)
+// storeAPIKeyValue keeps the value of a new API key in state. Only its hash is kept when the value was passed through
+// or written to a credentials sink.
+func storeAPIKeyValue(context context.Context, d *schema.ResourceData, meta interface{}, apiKey *iamidentityv1.APIKey) error {
+	passedThrough := d.Get("apikey").(string) != ""
+	if secretsmanager.CredentialsSinkEnabled(d) {
+		if err := secretsmanager.WriteCredentialsToSink(context, d, meta, string(apiKeyDetails(apiKey))); err != nil {
+			return err
+		}
+	} else if !passedThrough {
+		d.Set("apikey", *apiKey.Apikey)
+		return nil
+	}
+	hash, err := flex.HashSecret(*apiKey.Apikey)
+	if err != nil {
+		return err
 	}
+	d.Set("apikey", hash)
+	return nil
+}
 
-	return err
+// setAPIKeyValue sets the value of a stored API key returned by the API, a hash in state is kept while it matches the value
+func setAPIKeyValue(d *schema.ResourceData, value string) error {
+	if value == "" {
+		return nil
+	}
+	if current := d.Get("apikey").(string); flex.IsHashedSecret(current) || secretsmanager.CredentialsSinkEnabled(d) {
+		hash, err := flex.HashedSecretState(current, value)
+		if err != nil {
+			return err
+		}
+		return d.Set("apikey", hash)
+	}
+	return d.Set("apikey", value)
 }
@@ -54,6 +54,35 @@ func TestAccIBMIAMServiceAPIKey_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMServiceAPIKey_Rotation(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_iam_ser_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("terraform_iam_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMServiceAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_service_api_key.testacc_apiKey", "name", name),
+					resource.TestCheckResourceAttr("ibm_iam_service_api_key.testacc_apiKey", "previous_apikey_id", ""),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_iam_service_api_key.testacc_apiKey", "previous_apikey_id"),
+					resource.TestCheckResourceAttrSet("ibm_iam_service_api_key.testacc_apiKey", "previous_apikey_expires_at"),
+					resource.TestCheckResourceAttrSet("ibm_iam_service_api_key.testacc_apiKey", "apikey"),
+					testAccCheckIBMIAMServiceAPIKeyPreviousExists("ibm_iam_service_api_key.testacc_apiKey"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMIAMServiceAPIKey_import(t *testing.T) {
 	var apiKey string
 	serviceName := fmt.Sprintf("terraform_iam_ser_%d", acctest.RandIntRange(10, 100))
@@ -132,6 +161,31 @@ func testAccCheckIBMIAMServiceAPIKeyExists(n string, apiKey string) resource.Tes
 	}
 }
 
+func testAccCheckIBMIAMServiceAPIKeyPreviousExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return err
+		}
+
+		previousID := rs.Primary.Attributes["previous_apikey_id"]
+		if previousID == rs.Primary.ID {
+			return fmt.Errorf("Service API Key %s was not rotated", rs.Primary.ID)
+		}
+		getAPIKeyOptions := &iamidentityv1.GetAPIKeyOptions{
+			ID: &previousID,
+		}
+
+		_, _, err = rsContClient.GetAPIKey(getAPIKeyOptions)
+		return err
+	}
+}
+
 func testAccCheckIBMIAMServiceAPIKeyBasic(serviceName, name string) string {
 	return fmt.Sprintf(`
 		
@@ -191,3 +245,18 @@ func testAccCheckIBMIAMServiceAPIKeyImport(serviceName, name string) string {
 	  	}
 	`, serviceName, name)
 }
+
+func testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name, rotateTrigger string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%s"
+		}
+		resource "ibm_iam_service_api_key" "testacc_apiKey" {
+			name             = "%s"
+			iam_service_id   = ibm_iam_service_id.serviceID.iam_id
+			rotate_trigger   = "%s"
+			rotation_overlap = "24h"
+		}
+	`, serviceName, name, rotateTrigger)
+}
@@ -0,0 +1,335 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	userMfaEnrollments = "ibm_iam_user_mfa_enrollments"
+)
+
+// userMfa is the MFA trait of a user in the account settings, which the IAM Identity SDK does not provide
+type userMfa struct {
+	IamID string `json:"iam_id"`
+	Mfa   string `json:"mfa"`
+}
+
+type userMfaAccountSettings struct {
+	EntityTag string    `json:"entity_tag,omitempty"`
+	UserMfa   []userMfa `json:"user_mfa"`
+}
+
+type mfaEnrollmentTypeStatus struct {
+	Required bool `json:"required"`
+	Enrolled bool `json:"enrolled"`
+}
+
+type mfaStatus struct {
+	IamID            string `json:"iam_id"`
+	EffectiveMfaType string `json:"effective_mfa_type"`
+	IDBasedMfa       struct {
+		TraitAccountDefault string `json:"trait_account_default"`
+		TraitUserSpecific   string `json:"trait_user_specific"`
+		TraitEffective      string `json:"trait_effective"`
+		Complies            bool   `json:"complies"`
+	} `json:"id_based_mfa"`
+	AccountBasedMfa struct {
+		SecurityQuestions *mfaEnrollmentTypeStatus `json:"security_questions"`
+		Totp              *mfaEnrollmentTypeStatus `json:"totp"`
+		Verisign          *mfaEnrollmentTypeStatus `json:"verisign"`
+		Complies          bool                     `json:"complies"`
+	} `json:"account_based_mfa"`
+}
+
+func ResourceIBMIAMUserMfaEnrollments() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmIamUserMfaEnrollmentsCreate,
+		ReadContext:   resourceIbmIamUserMfaEnrollmentsRead,
+		UpdateContext: resourceIbmIamUserMfaEnrollmentsUpdate,
+		DeleteContext: resourceIbmIamUserMfaEnrollmentsDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the account.",
+			},
+			"iam_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IAM ID of the user.",
+			},
+			"mfa": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator(userMfaEnrollments, "mfa"),
+				Description:  "Defines the MFA requirement for the user. Valid values:  * NONE - No MFA trait set  * NONE_NO_ROPC - No MFA, disable CLI logins with only a password  * TOTP - For all non-federated IBMId users  * TOTP4ALL - For all users  * LEVEL1 - Email-based MFA for all users  * LEVEL2 - TOTP-based MFA for all users  * LEVEL3 - U2F MFA for all users.",
+			},
+			"effective_mfa_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The MFA type that is enforced for the user.",
+			},
+			"trait_account_default": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The MFA trait of the account.",
+			},
+			"trait_effective": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The MFA trait that is in effect for the user.",
+			},
+			"complies": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the user is enrolled in the MFA factors that are required for the user.",
+			},
+			"enrollments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The MFA factors of the account based MFA and whether the user is enrolled.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The MFA factor: security_questions, totp or verisign.",
+						},
+						"required": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the MFA factor is required for the user.",
+						},
+						"enrolled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the user is enrolled in the MFA factor.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMIAMUserMfaEnrollmentsValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 mfa,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "NONE, NONE_NO_ROPC, TOTP, TOTP4ALL, LEVEL1, LEVEL2, LEVEL3"})
+
+	ibmIAMUserMfaEnrollmentsValidator := validate.ResourceValidator{ResourceName: userMfaEnrollments, Schema: validateSchema}
+	return &ibmIAMUserMfaEnrollmentsValidator
+}
+
+func resourceIbmIamUserMfaEnrollmentsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	accountID := userDetails.UserAccount
+	iamID := d.Get("iam_id").(string)
+
+	err = setUserMfa(context, meta, accountID, iamID, d.Get("mfa").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, iamID))
+
+	return resourceIbmIamUserMfaEnrollmentsRead(context, d, meta)
+}
+
+func resourceIbmIamUserMfaEnrollmentsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) < 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of accountID/iamID", d.Id()))
+	}
+	accountID := parts[0]
+	iamID := parts[1]
+
+	settings, response, err := getUserMfaAccountSettings(context, iamIdentityClient, accountID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetAccountSettings failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving the MFA of user %s: %s", iamID, err))
+	}
+	userMfaFound := false
+	for _, userMfa := range settings.UserMfa {
+		if userMfa.IamID == iamID {
+			d.Set("mfa", userMfa.Mfa)
+			userMfaFound = true
+			break
+		}
+	}
+	if !userMfaFound {
+		d.SetId("")
+		return nil
+	}
+
+	status := mfaStatus{}
+	response, err = iamIdentityRequest(context, iamIdentityClient, http.MethodGet, "/v1/mfa/accounts/{account_id}/iam_ids/{iam_id}", map[string]string{"account_id": accountID, "iam_id": iamID}, nil, nil, &status)
+	if err != nil {
+		log.Printf("[DEBUG] GetMfaStatus failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving the MFA status of user %s: %s", iamID, err))
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("iam_id", iamID)
+	d.Set("effective_mfa_type", status.EffectiveMfaType)
+	d.Set("trait_account_default", status.IDBasedMfa.TraitAccountDefault)
+	d.Set("trait_effective", status.IDBasedMfa.TraitEffective)
+	d.Set("complies", status.IDBasedMfa.Complies && status.AccountBasedMfa.Complies)
+
+	enrollments := []map[string]interface{}{}
+	enrollmentTypes := []string{"security_questions", "totp", "verisign"}
+	for i, enrollment := range []*mfaEnrollmentTypeStatus{
+		status.AccountBasedMfa.SecurityQuestions,
+		status.AccountBasedMfa.Totp,
+		status.AccountBasedMfa.Verisign,
+	} {
+		if enrollment == nil {
+			continue
+		}
+		enrollments = append(enrollments, map[string]interface{}{
+			"type":     enrollmentTypes[i],
+			"required": enrollment.Required,
+			"enrolled": enrollment.Enrolled,
+		})
+	}
+	if err = d.Set("enrollments", enrollments); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting enrollments: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmIamUserMfaEnrollmentsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("mfa") {
+		err := setUserMfa(context, meta, d.Get("account_id").(string), d.Get("iam_id").(string), d.Get("mfa").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIbmIamUserMfaEnrollmentsRead(context, d, meta)
+}
+
+func resourceIbmIamUserMfaEnrollmentsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := setUserMfa(context, meta, d.Get("account_id").(string), d.Get("iam_id").(string), "")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+// setUserMfa sets the MFA trait of a user in the account settings, an empty mfa removes the MFA trait of the user.
+// The MFA traits of the other users are kept.
+func setUserMfa(context context.Context, meta interface{}, accountID, iamID, mfa string) error {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+
+	// The user MFA list of the account is updated as a whole
+	conns.IbmMutexKV.Lock(accountID)
+	defer conns.IbmMutexKV.Unlock(accountID)
+
+	settings, response, err := getUserMfaAccountSettings(context, iamIdentityClient, accountID)
+	if err != nil {
+		log.Printf("[DEBUG] GetAccountSettings failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error retrieving the MFA of user %s: %s", iamID, err)
+	}
+	userMfaList := []userMfa{}
+	for _, userMfa := range settings.UserMfa {
+		if userMfa.IamID != iamID {
+			userMfaList = append(userMfaList, userMfa)
+		}
+	}
+	if mfa != "" {
+		userMfaList = append(userMfaList, userMfa{IamID: iamID, Mfa: mfa})
+	}
+
+	ifMatch := settings.EntityTag
+	if ifMatch == "" {
+		ifMatch = "*"
+	}
+	body := userMfaAccountSettings{UserMfa: userMfaList}
+	response, err = iamIdentityRequest(context, iamIdentityClient, http.MethodPut, "/v1/accounts/{account_id}/settings/identity", map[string]string{"account_id": accountID}, map[string]string{"If-Match": ifMatch}, body, nil)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateAccountSettings failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error setting the MFA of user %s: %s", iamID, err)
+	}
+	return nil
+}
+
+func getUserMfaAccountSettings(context context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, accountID string) (*userMfaAccountSettings, *core.DetailedResponse, error) {
+	settings := &userMfaAccountSettings{}
+	response, err := iamIdentityRequest(context, iamIdentityClient, http.MethodGet, "/v1/accounts/{account_id}/settings/identity", map[string]string{"account_id": accountID}, nil, nil, settings)
+	return settings, response, err
+}
+
+// iamIdentityRequest calls an IAM Identity API that the SDK does not provide, using the endpoint and the authenticator of the SDK client
+func iamIdentityRequest(context context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, method, path string, pathParamsMap, headers map[string]string, body, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = iamIdentityClient.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(iamIdentityClient.Service.Options.URL, path, pathParamsMap)
+	if err != nil {
+		return nil, err
+	}
+	for headerName, headerValue := range headers {
+		if headerValue != "" {
+			builder.AddHeader(headerName, headerValue)
+		}
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		_, err = builder.SetBodyContentJSON(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return iamIdentityClient.Service.Request(request, result)
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMUserMfaEnrollmentsBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmIamUserMfaEnrollmentsConfig("LEVEL1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_user_mfa_enrollments.user_mfa", "mfa", "LEVEL1"),
+					resource.TestCheckResourceAttrSet("ibm_iam_user_mfa_enrollments.user_mfa", "account_id"),
+					resource.TestCheckResourceAttrSet("ibm_iam_user_mfa_enrollments.user_mfa", "trait_effective"),
+				),
+			},
+			{
+				Config: testAccCheckIbmIamUserMfaEnrollmentsConfig("NONE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_user_mfa_enrollments.user_mfa", "mfa", "NONE"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_user_mfa_enrollments.user_mfa",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmIamUserMfaEnrollmentsConfig(mfa string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_iam_user_profile" "user_profile" {
+			iam_id = "%s"
+		}
+
+		resource "ibm_iam_user_mfa_enrollments" "user_mfa" {
+			iam_id = data.ibm_iam_user_profile.user_profile.ibm_id
+			mfa    = "%s"
+		}
+	`, acc.IAMUser, mfa)
+}
@@ -0,0 +1,345 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// accessGroupPoliciesFields are the arguments of ibm_iam_access_group_policy that each policy of ibm_iam_access_group_policies supports
+var accessGroupPoliciesFields = []string{
+	"roles",
+	"resources",
+	"resource_attributes",
+	"account_management",
+	"resource_tags",
+	"description",
+	"rule_conditions",
+	"rule_operator",
+	"pattern",
+	"version",
+}
+
+func ResourceIBMIAMAccessGroupPolicies() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMIAMAccessGroupPoliciesCreate,
+		Read:   resourceIBMIAMAccessGroupPoliciesRead,
+		Update: resourceIBMIAMAccessGroupPoliciesUpdate,
+		Delete: resourceIBMIAMAccessGroupPoliciesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of access group",
+			},
+
+			"policies": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The complete list of access policies of the access group. Policies of the access group that are not in the list are deleted",
+				Elem: &schema.Resource{
+					Schema: accessGroupPoliciesPolicySchema(),
+				},
+			},
+		},
+	}
+}
+
+// accessGroupPoliciesPolicySchema returns the schema of a policy of ibm_iam_access_group_policies,
+// which is the schema of ibm_iam_access_group_policy without the access group and the conflicts and
+// requirements between the top level arguments, and the computed ID of the policy.
+func accessGroupPoliciesPolicySchema() map[string]*schema.Schema {
+	policySchema := ResourceIBMIAMAccessGroupPolicy().Schema
+	result := map[string]*schema.Schema{
+		"policy_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the policy",
+		},
+	}
+	for _, field := range accessGroupPoliciesFields {
+		fieldSchema := *policySchema[field]
+		fieldSchema.ConflictsWith = nil
+		fieldSchema.RequiredWith = nil
+		result[field] = &fieldSchema
+	}
+	return result
+}
+
+// accessGroupPolicyData returns the ibm_iam_access_group_policy data of a policy of ibm_iam_access_group_policies,
+// so that the policies are managed like the ibm_iam_access_group_policy resources.
+func accessGroupPolicyData(accessGroupID, policyID string, policy map[string]interface{}) (*schema.ResourceData, error) {
+	policyData := ResourceIBMIAMAccessGroupPolicy().Data(nil)
+	if policyID != "" {
+		policyData.SetId(fmt.Sprintf("%s/%s", accessGroupID, policyID))
+	}
+	if err := policyData.Set("access_group_id", accessGroupID); err != nil {
+		return nil, err
+	}
+	for field, value := range policy {
+		if field == "policy_id" {
+			continue
+		}
+		if set, ok := value.(*schema.Set); ok {
+			value = set.List()
+		}
+		if err := policyData.Set(field, value); err != nil {
+			return nil, fmt.Errorf("[ERROR] Error setting %s of access group policy: %s", field, err)
+		}
+	}
+	return policyData, nil
+}
+
+// flattenAccessGroupPolicyData returns the policy of ibm_iam_access_group_policies of ibm_iam_access_group_policy data
+func flattenAccessGroupPolicyData(policyData *schema.ResourceData) (map[string]interface{}, error) {
+	parts, err := flex.IdParts(policyData.Id())
+	if err != nil {
+		return nil, err
+	}
+	policy := map[string]interface{}{
+		"policy_id": parts[1],
+	}
+	for _, field := range accessGroupPoliciesFields {
+		value := policyData.Get(field)
+		if set, ok := value.(*schema.Set); ok {
+			value = set.List()
+		}
+		policy[field] = value
+	}
+	return policy, nil
+}
+
+func createAccessGroupPoliciesPolicy(accessGroupID string, policy map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	policyData, err := accessGroupPolicyData(accessGroupID, "", policy)
+	if err != nil {
+		return nil, err
+	}
+	err = resourceIBMIAMAccessGroupPolicyCreate(policyData, meta)
+	if err != nil {
+		return nil, err
+	}
+	return flattenAccessGroupPolicyData(policyData)
+}
+
+func deleteAccessGroupPoliciesPolicy(accessGroupID, policyID string, meta interface{}) error {
+	policyData, err := accessGroupPolicyData(accessGroupID, policyID, map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	return resourceIBMIAMAccessGroupPolicyDelete(policyData, meta)
+}
+
+func resourceIBMIAMAccessGroupPoliciesCreate(d *schema.ResourceData, meta interface{}) error {
+	accessGroupID := d.Get("access_group_id").(string)
+
+	policies := []map[string]interface{}{}
+	for _, p := range d.Get("policies").([]interface{}) {
+		policy, err := createAccessGroupPoliciesPolicy(accessGroupID, p.(map[string]interface{}), meta)
+		if err != nil {
+			if len(policies) > 0 {
+				d.SetId(accessGroupID)
+				d.Set("policies", policies)
+			}
+			return err
+		}
+		policies = append(policies, policy)
+	}
+	d.SetId(accessGroupID)
+	d.Set("policies", policies)
+
+	return resourceIBMIAMAccessGroupPoliciesRead(d, meta)
+}
+
+func resourceIBMIAMAccessGroupPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+
+	accessGroupID := d.Id()
+	query := map[string]string{
+		"account_id":      userDetails.UserAccount,
+		"access_group_id": accessGroupID,
+		"type":            "access",
+	}
+	existingPolicies, res, err := flex.ListV2Policies(iamPolicyManagementClient, query, "")
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing policies of access group %s: %s\n%s", accessGroupID, err, res)
+	}
+	existing := map[string]bool{}
+	for _, p := range existingPolicies {
+		existing[p.ID] = true
+	}
+
+	// The managed policies keep their order, policies that were deleted outside of Terraform are dropped
+	// so that they are created again.
+	policies := []map[string]interface{}{}
+	managed := map[string]bool{}
+	for _, p := range d.Get("policies").([]interface{}) {
+		policy := p.(map[string]interface{})
+		policyID := policy["policy_id"].(string)
+		if policyID == "" {
+			continue
+		}
+		policyData, err := accessGroupPolicyData(accessGroupID, policyID, policy)
+		if err != nil {
+			return err
+		}
+		if !existing[policyID] {
+			// The list of policies may not contain a policy that was just created yet
+			exists, err := resourceIBMIAMAccessGroupPolicyExists(policyData, meta)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				continue
+			}
+		}
+		err = resourceIBMIAMAccessGroupPolicyRead(policyData, meta)
+		if err != nil {
+			return err
+		}
+		policy, err = flattenAccessGroupPolicyData(policyData)
+		if err != nil {
+			return err
+		}
+		policies = append(policies, policy)
+		managed[policyID] = true
+	}
+
+	// Policies that are not managed by the resource are added as drift, so that they are deleted on apply
+	for _, p := range existingPolicies {
+		if managed[p.ID] {
+			continue
+		}
+		policyData, err := accessGroupPolicyData(accessGroupID, p.ID, map[string]interface{}{})
+		if err != nil {
+			return err
+		}
+		_, resourceAttributes, err := importAccessGroupPolicy(policyData, meta)
+		if err != nil {
+			return err
+		}
+		policyData.Set("resource_attributes", resourceAttributes)
+		err = resourceIBMIAMAccessGroupPolicyRead(policyData, meta)
+		if err != nil {
+			return err
+		}
+		policy, err := flattenAccessGroupPolicyData(policyData)
+		if err != nil {
+			return err
+		}
+		policies = append(policies, policy)
+	}
+
+	d.Set("access_group_id", accessGroupID)
+	if err = d.Set("policies", policies); err != nil {
+		return fmt.Errorf("[ERROR] Error setting policies: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIBMIAMAccessGroupPoliciesUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("policies") {
+		accessGroupID := d.Id()
+		oldRaw, newRaw := d.GetChange("policies")
+		oldPolicies := oldRaw.([]interface{})
+		newPolicies := newRaw.([]interface{})
+
+		// The policies in the state beyond the configured policies, including the unmanaged ones, are deleted
+		for i := len(newPolicies); i < len(oldPolicies); i++ {
+			policyID := oldPolicies[i].(map[string]interface{})["policy_id"].(string)
+			if policyID == "" {
+				continue
+			}
+			if err := deleteAccessGroupPoliciesPolicy(accessGroupID, policyID, meta); err != nil {
+				return err
+			}
+		}
+
+		policies := []map[string]interface{}{}
+		for i, p := range newPolicies {
+			policy := p.(map[string]interface{})
+			policyID := ""
+			var oldPolicy map[string]interface{}
+			if i < len(oldPolicies) {
+				oldPolicy = oldPolicies[i].(map[string]interface{})
+				policyID = oldPolicy["policy_id"].(string)
+			}
+			if policyID == "" {
+				createdPolicy, err := createAccessGroupPoliciesPolicy(accessGroupID, policy, meta)
+				if err != nil {
+					return err
+				}
+				policies = append(policies, createdPolicy)
+				continue
+			}
+			if !d.HasChange(fmt.Sprintf("policies.%d", i)) {
+				policies = append(policies, oldPolicy)
+				continue
+			}
+
+			// A policy with rule conditions can only be changed to a policy without rule conditions by replacing it
+			if oldPolicy["rule_conditions"].(*schema.Set).Len() > 0 && policy["rule_conditions"].(*schema.Set).Len() == 0 {
+				if err := deleteAccessGroupPoliciesPolicy(accessGroupID, policyID, meta); err != nil {
+					return err
+				}
+				createdPolicy, err := createAccessGroupPoliciesPolicy(accessGroupID, policy, meta)
+				if err != nil {
+					return err
+				}
+				policies = append(policies, createdPolicy)
+				continue
+			}
+
+			policyData, err := accessGroupPolicyData(accessGroupID, policyID, policy)
+			if err != nil {
+				return err
+			}
+			policyData.Set("version", oldPolicy["version"])
+			err = resourceIBMIAMAccessGroupPolicyUpdate(policyData, meta)
+			if err != nil {
+				return err
+			}
+			updatedPolicy, err := flattenAccessGroupPolicyData(policyData)
+			if err != nil {
+				return err
+			}
+			policies = append(policies, updatedPolicy)
+		}
+		d.Set("policies", policies)
+	}
+
+	return resourceIBMIAMAccessGroupPoliciesRead(d, meta)
+}
+
+func resourceIBMIAMAccessGroupPoliciesDelete(d *schema.ResourceData, meta interface{}) error {
+	accessGroupID := d.Id()
+	for _, p := range d.Get("policies").([]interface{}) {
+		policyID := p.(map[string]interface{})["policy_id"].(string)
+		if policyID == "" {
+			continue
+		}
+		if err := deleteAccessGroupPoliciesPolicy(accessGroupID, policyID, meta); err != nil {
+			return err
+		}
+	}
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMAccessGroupPolicies_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupPoliciesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupPoliciesBasic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group.accgrp", "name", name),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policies.#", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policies.0.resources.0.service", "kms"),
+					resource.TestCheckResourceAttrSet("ibm_iam_access_group_policies.policies", "policies.0.policy_id"),
+					resource.TestCheckResourceAttrSet("ibm_iam_access_group_policies.policies", "policies.1.policy_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupPoliciesUpdate(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policies.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policies.0.roles.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMIAMAccessGroupPolicies_Unmanaged(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupPoliciesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupPoliciesUpdate(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policies.#", "1"),
+					testAccCheckIBMIAMAccessGroupPoliciesAddUnmanaged("ibm_iam_access_group_policies.policies"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupPoliciesUpdate(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policies.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccessGroupPoliciesDestroy(s *terraform.State) error {
+	iamPolicyManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_access_group_policies" {
+			continue
+		}
+		for key, policyID := range rs.Primary.Attributes {
+			if len(key) < len("policy_id") || key[len(key)-len("policy_id"):] != "policy_id" {
+				continue
+			}
+			getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
+				policyID,
+			)
+			destroyedPolicy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+			if err == nil && *destroyedPolicy.State != "deleted" {
+				return fmt.Errorf("Access group policy still exists: %s\n", policyID)
+			} else if response.StatusCode != 404 && destroyedPolicy.State != nil && *destroyedPolicy.State != "deleted" {
+				return fmt.Errorf("[ERROR] Error waiting for access group policy (%s) to be destroyed: %s", policyID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// testAccCheckIBMIAMAccessGroupPoliciesAddUnmanaged adds a policy to the access group outside of Terraform
+func testAccCheckIBMIAMAccessGroupPoliciesAddUnmanaged(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		iamPolicyManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
+		if err != nil {
+			return err
+		}
+		userDetails, err := acc.TestAccProvider.Meta().(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return err
+		}
+		accessGroupID := rs.Primary.ID
+		accountID := userDetails.UserAccount
+		createPolicyOptions := iamPolicyManagementClient.NewCreatePolicyOptions(
+			"access",
+			[]iampolicymanagementv1.PolicySubject{
+				{
+					Attributes: []iampolicymanagementv1.SubjectAttribute{
+						{Name: &[]string{"access_group_id"}[0], Value: &accessGroupID},
+					},
+				},
+			},
+			[]iampolicymanagementv1.PolicyRole{
+				{RoleID: &[]string{"crn:v1:bluemix:public:iam::::role:Viewer"}[0]},
+			},
+			[]iampolicymanagementv1.PolicyResource{
+				{
+					Attributes: []iampolicymanagementv1.ResourceAttribute{
+						{Name: &[]string{"accountId"}[0], Value: &accountID},
+						{Name: &[]string{"serviceName"}[0], Value: &[]string{"cloud-object-storage"}[0]},
+					},
+				},
+			},
+		)
+		_, response, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating unmanaged access group policy: %s\n%s", err, response)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMIAMAccessGroupPoliciesBasic(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_access_group_policies" "policies" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+			policies {
+				roles = ["Viewer"]
+				resources {
+					service = "kms"
+				}
+			}
+			policies {
+				roles = ["Viewer"]
+				resource_attributes {
+					name  = "serviceName"
+					value = "messagehub"
+				}
+			}
+		}
+	`, name)
+}
+
+func testAccCheckIBMIAMAccessGroupPoliciesUpdate(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_access_group_policies" "policies" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+			policies {
+				roles = ["Viewer", "Manager"]
+				resources {
+					service = "kms"
+				}
+			}
+		}
+	`, name)
+}
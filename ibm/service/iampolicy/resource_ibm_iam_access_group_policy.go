@@ -9,6 +9,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -181,6 +182,49 @@ func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
 				Description: "Description of the Policy",
 			},
 
+			"rule_conditions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Rule conditions enforced by the policy",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Key of the condition",
+						},
+						"operator": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Operator of the condition",
+						},
+						"value": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Value of the condition",
+						},
+					},
+				},
+			},
+
+			"rule_operator": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"rule_conditions"},
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"and", "or"}),
+				Description:  "Operator used to evaluate multiple rule conditions, and or or",
+			},
+
+			"pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"rule_conditions"},
+				Description:  "Pattern rule follows for time-based condition",
+			},
+
 			"transaction_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -251,7 +295,17 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 		createPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	accessGroupPolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	var accessGroupPolicy *iampolicymanagementv1.Policy
+	var res *core.DetailedResponse
+	if flex.IsV2Policy(d) {
+		var v2Policy *flex.V2Policy
+		v2Policy, res, err = flex.CreateV2Policy(iamPolicyManagementClient, flex.GenerateV2Policy(d, *accessGroupIdSubject, *policyResource, policyOptions.Roles), d.Get("transaction_id").(string))
+		if err == nil && v2Policy != nil {
+			accessGroupPolicy = &iampolicymanagementv1.Policy{ID: &v2Policy.ID}
+		}
+	} else {
+		accessGroupPolicy, res, err = iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	}
 	if err != nil || accessGroupPolicy == nil {
 		return fmt.Errorf("[ERROR] Error creating access group policy: %s\n%s", err, res)
 	}
@@ -266,7 +320,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, res, err := flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
 				return resource.RetryableError(err)
@@ -277,7 +331,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, _, res, err = flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 	}
 	if err != nil {
 		d.SetId(fmt.Sprintf("%s/%s", accessGroupId, *accessGroupPolicy.ID))
@@ -311,10 +365,11 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 	}
 
 	accessGroupPolicy := &iampolicymanagementv1.Policy{}
+	var v2Policy *flex.V2Policy
 	res := &core.DetailedResponse{}
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		accessGroupPolicy, v2Policy, res, err = flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 		if err != nil || accessGroupPolicy == nil {
 			if res != nil && res.StatusCode == 404 {
 				return resource.RetryableError(err)
@@ -325,7 +380,7 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		accessGroupPolicy, v2Policy, res, err = flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 	}
 	if err != nil || accessGroupPolicy == nil || res == nil {
 		return fmt.Errorf("[ERROR] Error retrieving access group policy: %s\n%s", err, res)
@@ -368,6 +423,8 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 		d.Set("description", *accessGroupPolicy.Description)
 	}
 
+	flex.SetPolicyRule(d, v2Policy)
+
 	if len(res.Headers["Transaction-Id"]) > 0 && res.Headers["Transaction-Id"][0] != "" {
 		d.Set("transaction_id", res.Headers["Transaction-Id"][0])
 	}
@@ -381,7 +438,7 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return err
 	}
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
 			return err
@@ -437,7 +494,12 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 			updatePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		_, res, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		var res *core.DetailedResponse
+		if flex.IsV2Policy(d) {
+			_, res, err = flex.UpdateV2Policy(iamPolicyManagementClient, accessGroupPolicyId, d.Get("version").(string), flex.GenerateV2Policy(d, *accessGroupIdSubject, *policyResource, policyOptions.Roles), d.Get("transaction_id").(string))
+		} else {
+			_, res, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		}
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating access group policy: %s\n%s", err, res)
 		}
@@ -467,7 +529,12 @@ func resourceIBMIAMAccessGroupPolicyDelete(d *schema.ResourceData, meta interfac
 		deletePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	res, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	var res *core.DetailedResponse
+	if flex.IsV2Policy(d) {
+		res, err = flex.DeleteV2Policy(iamPolicyManagementClient, accessGroupPolicyId, d.Get("transaction_id").(string))
+	} else {
+		res, err = iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	}
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting access group policy: %s\n%s", err, res)
 	}
@@ -496,7 +563,7 @@ func resourceIBMIAMAccessGroupPolicyExists(d *schema.ResourceData, meta interfac
 		accessGroupPolicyId,
 	)
 
-	accessGroupPolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	accessGroupPolicy, _, resp, err := flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 	if err != nil || accessGroupPolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -529,10 +596,18 @@ func importAccessGroupPolicy(d *schema.ResourceData, meta interface{}) (interfac
 		accgrpPolicyID,
 	)
 
-	accessGroupPolicy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	v2Policy, res, err := flex.GetV2Policy(iamPolicyManagementClient, accgrpPolicyID, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("[ERROR] Error retrieving access group policy: %s\n%s", err, res)
+	}
+
+	accessGroupPolicy, _, res, err := flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, v2Policy.Rule != nil, meta)
 	if err != nil {
 		return nil, nil, fmt.Errorf("[ERROR] Error retrieving access group policy: %s\n%s", err, res)
 	}
+	if v2Policy.Rule != nil {
+		flex.SetPolicyRule(d, v2Policy)
+	}
 
 	resources := flex.FlattenPolicyResource(accessGroupPolicy.Resources)
 	resource_attributes := flex.FlattenPolicyResourceAttributes(accessGroupPolicy.Resources)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
@@ -190,6 +191,49 @@ func ResourceIBMIAMServicePolicy() *schema.Resource {
 				Description: "Description of the Policy",
 			},
 
+			"rule_conditions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Rule conditions enforced by the policy",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Key of the condition",
+						},
+						"operator": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Operator of the condition",
+						},
+						"value": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Value of the condition",
+						},
+					},
+				},
+			},
+
+			"rule_operator": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"rule_conditions"},
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"and", "or"}),
+				Description:  "Operator used to evaluate multiple rule conditions, and or or",
+			},
+
+			"pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"rule_conditions"},
+				Description:  "Pattern rule follows for time-based condition",
+			},
+
 			"transaction_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -274,7 +318,17 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 		createPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	servicePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	var servicePolicy *iampolicymanagementv1.Policy
+	var res *core.DetailedResponse
+	if flex.IsV2Policy(d) {
+		var v2Policy *flex.V2Policy
+		v2Policy, res, err = flex.CreateV2Policy(iamPolicyManagementClient, flex.GenerateV2Policy(d, *policySubjects, policyResources, policyOptions.Roles), d.Get("transaction_id").(string))
+		if err == nil && v2Policy != nil {
+			servicePolicy = &iampolicymanagementv1.Policy{ID: &v2Policy.ID}
+		}
+	} else {
+		servicePolicy, res, err = iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	}
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating servicePolicy: %s %s", err, res)
 	}
@@ -289,7 +343,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, res, err := flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -301,7 +355,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, _, res, err = flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 	}
 	if err != nil {
 		if v, ok := d.GetOk("iam_service_id"); ok && v != nil {
@@ -338,6 +392,7 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 	serviceIDUUID := parts[0]
 	servicePolicyID := parts[1]
 	servicePolicy := &iampolicymanagementv1.Policy{}
+	var v2Policy *flex.V2Policy
 	res := &core.DetailedResponse{}
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		servicePolicyID,
@@ -349,7 +404,7 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		servicePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		servicePolicy, v2Policy, res, err = flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 
 		if err != nil || servicePolicy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -361,7 +416,7 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		servicePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		servicePolicy, v2Policy, res, err = flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 	}
 	if err != nil || servicePolicy == nil || res == nil {
 		return fmt.Errorf("[ERROR] Error retrieving servicePolicy: %s %s", err, res)
@@ -401,6 +456,8 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("description", *servicePolicy.Description)
 	}
 
+	flex.SetPolicyRule(d, v2Policy)
+
 	if len(res.Headers["Transaction-Id"]) > 0 && res.Headers["Transaction-Id"][0] != "" {
 		d.Set("transaction_id", res.Headers["Transaction-Id"][0])
 	}
@@ -410,7 +467,7 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
@@ -486,7 +543,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 			getPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, response, err := flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 		if err != nil || policy == nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
@@ -512,7 +569,11 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 		if transactionID, ok := d.GetOk("transaction_id"); ok {
 			updatePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
-		_, _, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		if flex.IsV2Policy(d) {
+			_, _, err = flex.UpdateV2Policy(iamPolicyManagementClient, servicePolicyID, servicePolicyETag, flex.GenerateV2Policy(d, *policySubjects, policyResources, createPolicyOptions.Roles), d.Get("transaction_id").(string))
+		} else {
+			_, _, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		}
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating service policy: %s", err)
 		}
@@ -543,7 +604,11 @@ func resourceIBMIAMServicePolicyDelete(d *schema.ResourceData, meta interface{})
 		deletePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	_, err = iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	if flex.IsV2Policy(d) {
+		_, err = flex.DeleteV2Policy(iamPolicyManagementClient, servicePolicyID, d.Get("transaction_id").(string))
+	} else {
+		_, err = iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	}
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting service policy: %s", err)
 	}
@@ -572,7 +637,7 @@ func resourceIBMIAMServicePolicyExists(d *schema.ResourceData, meta interface{})
 		servicePolicyID,
 	)
 
-	servicePolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	servicePolicy, _, resp, err := flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 	if err != nil || servicePolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -603,10 +668,17 @@ func importServicePolicy(d *schema.ResourceData, meta interface{}) (interface{},
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		servicePolicyID,
 	)
-	servicePolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	v2Policy, _, err := flex.GetV2Policy(iamPolicyManagementClient, servicePolicyID, "")
 	if err != nil {
 		return nil, nil, fmt.Errorf("[ERROR] Error retrieving servicePolicy: %s", err)
 	}
+	servicePolicy, _, _, err := flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, v2Policy.Rule != nil, meta)
+	if err != nil {
+		return nil, nil, fmt.Errorf("[ERROR] Error retrieving servicePolicy: %s", err)
+	}
+	if v2Policy.Rule != nil {
+		flex.SetPolicyRule(d, v2Policy)
+	}
 	resources := flex.FlattenPolicyResource(servicePolicy.Resources)
 	resource_attributes := flex.FlattenPolicyResourceAttributes(servicePolicy.Resources)
 	d.Set("resource_tags", flex.FlattenPolicyResourceTags(servicePolicy.Resources))
@@ -10,6 +10,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
@@ -190,6 +191,49 @@ func ResourceIBMIAMTrustedProfilePolicy() *schema.Resource {
 				Description: "Description of the Policy",
 			},
 
+			"rule_conditions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Rule conditions enforced by the policy",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Key of the condition",
+						},
+						"operator": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Operator of the condition",
+						},
+						"value": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Value of the condition",
+						},
+					},
+				},
+			},
+
+			"rule_operator": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"rule_conditions"},
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"and", "or"}),
+				Description:  "Operator used to evaluate multiple rule conditions, and or or",
+			},
+
+			"pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"rule_conditions"},
+				Description:  "Pattern rule follows for time-based condition",
+			},
+
 			"transaction_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -274,7 +318,17 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 		createPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	trustedProfilePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	var trustedProfilePolicy *iampolicymanagementv1.Policy
+	var res *core.DetailedResponse
+	if flex.IsV2Policy(d) {
+		var v2Policy *flex.V2Policy
+		v2Policy, res, err = flex.CreateV2Policy(iamPolicyManagementClient, flex.GenerateV2Policy(d, *policySubjects, policyResources, policyOptions.Roles), d.Get("transaction_id").(string))
+		if err == nil && v2Policy != nil {
+			trustedProfilePolicy = &iampolicymanagementv1.Policy{ID: &v2Policy.ID}
+		}
+	} else {
+		trustedProfilePolicy, res, err = iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	}
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating trustedProfilePolicy: %s %s", err, res)
 	}
@@ -289,7 +343,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, res, err := flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -301,7 +355,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, _, res, err = flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 	}
 	if err != nil {
 		if v, ok := d.GetOk("profile_id"); ok && v != nil {
@@ -338,6 +392,7 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 	profileIDUUID := parts[0]
 	trustedProfilePolicyID := parts[1]
 	trustedProfilePolicy := &iampolicymanagementv1.Policy{}
+	var v2Policy *flex.V2Policy
 	res := &core.DetailedResponse{}
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		trustedProfilePolicyID,
@@ -348,7 +403,7 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		trustedProfilePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		trustedProfilePolicy, v2Policy, res, err = flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 
 		if err != nil || trustedProfilePolicy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -360,7 +415,7 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		trustedProfilePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		trustedProfilePolicy, v2Policy, res, err = flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 	}
 	if err != nil || trustedProfilePolicy == nil || res == nil {
 		return fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s %s", err, res)
@@ -399,6 +454,9 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 	if trustedProfilePolicy.Description != nil {
 		d.Set("description", *trustedProfilePolicy.Description)
 	}
+
+	flex.SetPolicyRule(d, v2Policy)
+
 	if len(res.Headers["Transaction-Id"]) > 0 && res.Headers["Transaction-Id"][0] != "" {
 		d.Set("transaction_id", res.Headers["Transaction-Id"][0])
 	}
@@ -408,7 +466,7 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 
 func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
@@ -479,7 +537,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 			getPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, response, err := flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 		if err != nil || policy == nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
@@ -506,7 +564,12 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 			updatePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		_, resp, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		var resp *core.DetailedResponse
+		if flex.IsV2Policy(d) {
+			_, resp, err = flex.UpdateV2Policy(iamPolicyManagementClient, trustedProfilePolicyID, trustedProfilePolicyETag, flex.GenerateV2Policy(d, *policySubjects, policyResources, createPolicyOptions.Roles), d.Get("transaction_id").(string))
+		} else {
+			_, resp, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		}
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating trusted profile policy: %s: %s", err, resp)
 		}
@@ -537,7 +600,12 @@ func resourceIBMIAMTrustedProfilePolicyDelete(d *schema.ResourceData, meta inter
 		deletePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	resp, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	var resp *core.DetailedResponse
+	if flex.IsV2Policy(d) {
+		resp, err = flex.DeleteV2Policy(iamPolicyManagementClient, trustedProfilePolicyID, d.Get("transaction_id").(string))
+	} else {
+		resp, err = iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	}
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting trusted profile policy: %s %s", err, resp)
 	}
@@ -566,7 +634,7 @@ func resourceIBMIAMTrustedProfilePolicyExists(d *schema.ResourceData, meta inter
 		trustedProfilePolicyID,
 	)
 
-	trustedProfilePolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	trustedProfilePolicy, _, resp, err := flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 	if err != nil || trustedProfilePolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -597,9 +665,16 @@ func importTrustedProfilePolicy(d *schema.ResourceData, meta interface{}) (inter
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		trustedProfilePolicyID,
 	)
-	trustedProfilePolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	v2Policy, _, err := flex.GetV2Policy(iamPolicyManagementClient, trustedProfilePolicyID, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s", err)
+	}
+	trustedProfilePolicy, _, _, err := flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, v2Policy.Rule != nil, meta)
 	if err != nil {
-		return nil, nil, fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s %s", err, resp)
+		return nil, nil, fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s", err)
+	}
+	if v2Policy.Rule != nil {
+		flex.SetPolicyRule(d, v2Policy)
 	}
 	resources := flex.FlattenPolicyResource(trustedProfilePolicy.Resources)
 	resource_attributes := flex.FlattenPolicyResourceAttributes(trustedProfilePolicy.Resources)
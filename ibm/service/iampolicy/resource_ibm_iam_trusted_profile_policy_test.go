@@ -323,6 +323,36 @@ func TestAccIBMIAMTrustedProfilePolicy_With_Transaction_Id(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMTrustedProfilePolicy_With_Time_Based_Conditions(t *testing.T) {
+	var conf iampolicymanagementv1.Policy
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMTrustedProfilePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMTrustedProfilePolicyTimeBasedConditions(name, "17:00:00+00:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMTrustedProfilePolicyExists("ibm_iam_trusted_profile_policy.policy", conf),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile.profileID", "name", name),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "rule_conditions.#", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "pattern", "time-based-conditions:weekly:custom-hours"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMTrustedProfilePolicyTimeBasedConditions(name, "18:00:00+00:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMTrustedProfilePolicyExists("ibm_iam_trusted_profile_policy.policy", conf),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "rule_conditions.#", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "rule_operator", "and"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIAMTrustedProfilePolicyDestroy(s *terraform.State) error {
 	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
@@ -710,3 +740,30 @@ func testAccCheckIBMIAMTrustedProfilePolicyUpdateResourceTags(name string) strin
 	  	}
 	`, name)
 }
+
+func testAccCheckIBMIAMTrustedProfilePolicyTimeBasedConditions(name, endTime string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_trusted_profile" "profileID" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_trusted_profile_policy" "policy" {
+			profile_id = ibm_iam_trusted_profile.profileID.id
+			roles      = ["Viewer"]
+			resources {
+				service = "kms"
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_time}}"
+				operator = "timeGreaterThanOrEquals"
+				value    = ["09:00:00+00:00"]
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_time}}"
+				operator = "timeLessThanOrEquals"
+				value    = ["%s"]
+			}
+			pattern = "time-based-conditions:weekly:custom-hours"
+		}
+	`, name, endTime)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -180,6 +181,49 @@ func ResourceIBMIAMUserPolicy() *schema.Resource {
 				Description: "Description of the Policy",
 			},
 
+			"rule_conditions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Rule conditions enforced by the policy",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Key of the condition",
+						},
+						"operator": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Operator of the condition",
+						},
+						"value": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Value of the condition",
+						},
+					},
+				},
+			},
+
+			"rule_operator": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"rule_conditions"},
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"and", "or"}),
+				Description:  "Operator used to evaluate multiple rule conditions, and or or",
+			},
+
+			"pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"rule_conditions"},
+				Description:  "Pattern rule follows for time-based condition",
+			},
+
 			"transaction_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -253,7 +297,17 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 		createPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	userPolicy, resp, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	var userPolicy *iampolicymanagementv1.Policy
+	var resp *core.DetailedResponse
+	if flex.IsV2Policy(d) {
+		var v2Policy *flex.V2Policy
+		v2Policy, resp, err = flex.CreateV2Policy(iamPolicyManagementClient, flex.GenerateV2Policy(d, *policySubjects, policyResources, policyOptions.Roles), d.Get("transaction_id").(string))
+		if err == nil && v2Policy != nil {
+			userPolicy = &iampolicymanagementv1.Policy{ID: &v2Policy.ID}
+		}
+	} else {
+		userPolicy, resp, err = iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	}
 	if err != nil {
 		return fmt.Errorf("Error creating user policies: %s, %s", err, resp)
 	}
@@ -268,7 +322,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, res, err := flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -280,7 +334,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, _, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, _, _, err = flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 	}
 	if err != nil {
 		d.SetId(fmt.Sprintf("%s/%s", userEmail, *userPolicy.ID))
@@ -314,10 +368,11 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	userPolicy := &iampolicymanagementv1.Policy{}
+	var v2Policy *flex.V2Policy
 	res := &core.DetailedResponse{}
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		userPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		userPolicy, v2Policy, res, err = flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 
 		if err != nil || userPolicy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -329,7 +384,7 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		userPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		userPolicy, v2Policy, res, err = flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 	}
 	if err != nil || userPolicy == nil || res == nil {
 		return fmt.Errorf("[ERROR] Error retrieving userPolicy: %s %s", err, res)
@@ -363,6 +418,9 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	if userPolicy.Description != nil {
 		d.Set("description", *userPolicy.Description)
 	}
+
+	flex.SetPolicyRule(d, v2Policy)
+
 	if len(res.Headers["Transaction-Id"]) > 0 && res.Headers["Transaction-Id"][0] != "" {
 		d.Set("transaction_id", res.Headers["Transaction-Id"][0])
 	}
@@ -375,7 +433,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return err
 	}
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
 			return err
@@ -427,7 +485,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 			getPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, response, err := flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 		if err != nil || policy == nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
@@ -454,7 +512,12 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 			updatePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
 
-		_, resp, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		var resp *core.DetailedResponse
+		if flex.IsV2Policy(d) {
+			_, resp, err = flex.UpdateV2Policy(iamPolicyManagementClient, userPolicyID, userPolicyETag, flex.GenerateV2Policy(d, *policySubjects, policyResources, createPolicyOptions.Roles), d.Get("transaction_id").(string))
+		} else {
+			_, resp, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		}
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating user policy: %s, %s", err, resp)
 		}
@@ -483,7 +546,11 @@ func resourceIBMIAMUserPolicyDelete(d *schema.ResourceData, meta interface{}) er
 		deletePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	_, err = iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	if flex.IsV2Policy(d) {
+		_, err = flex.DeleteV2Policy(iamPolicyManagementClient, userPolicyID, d.Get("transaction_id").(string))
+	} else {
+		_, err = iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	}
 	if err != nil {
 		return err
 	}
@@ -511,7 +578,7 @@ func resourceIBMIAMUserPolicyExists(d *schema.ResourceData, meta interface{}) (b
 		userPolicyID,
 	)
 
-	userPolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	userPolicy, _, resp, err := flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, flex.IsV2Policy(d), meta)
 	if err != nil || userPolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -543,10 +610,17 @@ func importUserPolicy(d *schema.ResourceData, meta interface{}) (interface{}, in
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		userPolicyID,
 	)
-	userPolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	v2Policy, _, err := flex.GetV2Policy(iamPolicyManagementClient, userPolicyID, "")
 	if err != nil {
 		return nil, nil, fmt.Errorf("[ERROR] Error retrieving User Policy: %s", err)
 	}
+	userPolicy, _, _, err := flex.GetPolicy(iamPolicyManagementClient, getPolicyOptions, v2Policy.Rule != nil, meta)
+	if err != nil {
+		return nil, nil, fmt.Errorf("[ERROR] Error retrieving User Policy: %s", err)
+	}
+	if v2Policy.Rule != nil {
+		flex.SetPolicyRule(d, v2Policy)
+	}
 	resources := flex.FlattenPolicyResource(userPolicy.Resources)
 	resource_attributes := flex.FlattenPolicyResourceAttributes(userPolicy.Resources)
 	d.Set("resource_tags", flex.FlattenPolicyResourceTags(userPolicy.Resources))
@@ -0,0 +1,320 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMKmsInstancePolicies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsInstancePoliciesUpdate,
+		ReadContext:   resourceIBMKmsInstancePoliciesRead,
+		UpdateContext: resourceIBMKmsInstancePoliciesUpdate,
+		DeleteContext: resourceIBMKmsInstancePoliciesDelete,
+		Importer:      &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect Instance GUID",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+			},
+			"dual_auth_delete": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Data associated with the dual authorization delete policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, Key Protect enables a dual authorization policy on the instance.",
+						},
+					},
+				},
+			},
+			"allowed_network": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Data associated with the allowed network policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, Key Protect enables the allowed network policy on the instance.",
+						},
+						"network": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "public-and-private",
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"public-and-private", "private-only"}),
+							Description:  "The type of the allowed network: public-and-private or private-only.",
+						},
+					},
+				},
+			},
+			"metrics": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Data associated with the metrics policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, Key Protect enables the metrics policy on the instance.",
+						},
+					},
+				},
+			},
+			"key_create_import_access": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Data associated with the key create and import access policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, Key Protect enables the key create and import access policy on the instance.",
+						},
+						"create_root_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, root keys can be created in the instance.",
+						},
+						"create_standard_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, standard keys can be created in the instance.",
+						},
+						"import_root_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, root keys can be imported in the instance.",
+						},
+						"import_standard_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, standard keys can be imported in the instance.",
+						},
+						"enforce_token": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "If set to true, keys can only be imported with an import token.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMKmsInstancePoliciesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, instanceCRN, err := kmsInstanceAPI(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policies := expandKmsInstancePolicies(d)
+	// policies removed from the configuration are disabled
+	disabledPolicies := disabledKmsInstancePolicies(d, func(policy string) bool {
+		_, ok := d.GetOk(policy)
+		return d.HasChange(policy) && !ok
+	})
+	if disabledPolicies.DualAuthDelete != nil {
+		policies.DualAuthDelete = disabledPolicies.DualAuthDelete
+	}
+	if disabledPolicies.Metrics != nil {
+		policies.Metrics = disabledPolicies.Metrics
+	}
+	if disabledPolicies.AllowedNetwork != nil {
+		policies.AllowedNetwork = disabledPolicies.AllowedNetwork
+	}
+	if disabledPolicies.KeyCreateImportAccess != nil {
+		policies.KeyCreateImportAccess = disabledPolicies.KeyCreateImportAccess
+	}
+	err = kpAPI.SetInstancePolicies(context, policies)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while setting instance policies: %s", err))
+	}
+	if d.IsNewResource() {
+		d.SetId(instanceCRN)
+	}
+
+	return resourceIBMKmsInstancePoliciesRead(context, d, meta)
+}
+
+func resourceIBMKmsInstancePoliciesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	crnData := strings.Split(d.Id(), ":")
+	if len(crnData) < 3 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be the CRN of the instance", d.Id()))
+	}
+	instanceID := crnData[len(crnData)-3]
+
+	kpAPI, _, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	policies, err := kpAPI.GetInstancePolicies(context)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && (kpError.StatusCode == 404 || kpError.StatusCode == 409) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Get Instance Policies failed with error: %s", err))
+	}
+
+	d.Set("instance_id", instanceID)
+	if strings.Contains((kpAPI.URL).String(), "private") || strings.Contains(kpAPI.Config.BaseURL, "private") {
+		d.Set("endpoint_type", "private")
+	} else {
+		d.Set("endpoint_type", "public")
+	}
+	// only the policies managed by the resource are read, all policies are read on import
+	managed := func(policy string) bool {
+		_, ok := d.GetOk(policy)
+		return ok
+	}
+	if !managed("dual_auth_delete") && !managed("metrics") && !managed("allowed_network") && !managed("key_create_import_access") {
+		managed = func(string) bool { return true }
+	}
+	for _, policy := range policies {
+		enabled := policy.PolicyData.Enabled != nil && *policy.PolicyData.Enabled
+		attributes := policy.PolicyData.Attributes
+		switch {
+		case policy.PolicyType == kp.DualAuthDelete && managed("dual_auth_delete"):
+			d.Set("dual_auth_delete", []map[string]interface{}{{"enabled": enabled}})
+		case policy.PolicyType == kp.Metrics && managed("metrics"):
+			d.Set("metrics", []map[string]interface{}{{"enabled": enabled}})
+		case policy.PolicyType == kp.AllowedNetwork && managed("allowed_network"):
+			allowedNetwork := map[string]interface{}{"enabled": enabled}
+			if attributes != nil && attributes.AllowedNetwork != nil {
+				allowedNetwork["network"] = *attributes.AllowedNetwork
+			}
+			d.Set("allowed_network", []map[string]interface{}{allowedNetwork})
+		case policy.PolicyType == kp.KeyCreateImportAccess && managed("key_create_import_access"):
+			keyCreateImportAccess := map[string]interface{}{"enabled": enabled}
+			if attributes != nil {
+				keyCreateImportAccess["create_root_key"] = attributes.CreateRootKey != nil && *attributes.CreateRootKey
+				keyCreateImportAccess["create_standard_key"] = attributes.CreateStandardKey != nil && *attributes.CreateStandardKey
+				keyCreateImportAccess["import_root_key"] = attributes.ImportRootKey != nil && *attributes.ImportRootKey
+				keyCreateImportAccess["import_standard_key"] = attributes.ImportStandardKey != nil && *attributes.ImportStandardKey
+				keyCreateImportAccess["enforce_token"] = attributes.EnforceToken != nil && *attributes.EnforceToken
+			}
+			d.Set("key_create_import_access", []map[string]interface{}{keyCreateImportAccess})
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMKmsInstancePoliciesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, _, err := kmsInstanceAPI(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// instance policies cannot be removed, the policies managed by the resource are disabled
+	policies := disabledKmsInstancePolicies(d, func(policy string) bool {
+		_, ok := d.GetOk(policy)
+		return ok
+	})
+	err = kpAPI.SetInstancePolicies(context, policies)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while disabling instance policies: %s", err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func expandKmsInstancePolicies(d *schema.ResourceData) kp.MultiplePolicies {
+	policies := kp.MultiplePolicies{}
+	if dualAuthDelete, ok := d.GetOk("dual_auth_delete"); ok {
+		policy := dualAuthDelete.([]interface{})[0].(map[string]interface{})
+		policies.DualAuthDelete = &kp.BasicPolicyData{Enabled: policy["enabled"].(bool)}
+	}
+	if metrics, ok := d.GetOk("metrics"); ok {
+		policy := metrics.([]interface{})[0].(map[string]interface{})
+		policies.Metrics = &kp.BasicPolicyData{Enabled: policy["enabled"].(bool)}
+	}
+	if allowedNetwork, ok := d.GetOk("allowed_network"); ok {
+		policy := allowedNetwork.([]interface{})[0].(map[string]interface{})
+		policies.AllowedNetwork = &kp.AllowedNetworkPolicyData{
+			Enabled: policy["enabled"].(bool),
+			Network: policy["network"].(string),
+		}
+	}
+	if keyCreateImportAccess, ok := d.GetOk("key_create_import_access"); ok {
+		policy := keyCreateImportAccess.([]interface{})[0].(map[string]interface{})
+		policies.KeyCreateImportAccess = &kp.KeyCreateImportAccessInstancePolicy{
+			Enabled:           policy["enabled"].(bool),
+			CreateRootKey:     policy["create_root_key"].(bool),
+			CreateStandardKey: policy["create_standard_key"].(bool),
+			ImportRootKey:     policy["import_root_key"].(bool),
+			ImportStandardKey: policy["import_standard_key"].(bool),
+			EnforceToken:      policy["enforce_token"].(bool),
+		}
+	}
+	return policies
+}
+
+// disabledKmsInstancePolicies returns the policies that disable the instance policies selected by disable
+func disabledKmsInstancePolicies(d *schema.ResourceData, disable func(policy string) bool) kp.MultiplePolicies {
+	policies := kp.MultiplePolicies{}
+	if disable("dual_auth_delete") {
+		policies.DualAuthDelete = &kp.BasicPolicyData{Enabled: false}
+	}
+	if disable("metrics") {
+		policies.Metrics = &kp.BasicPolicyData{Enabled: false}
+	}
+	if disable("allowed_network") {
+		// the network of a disabled policy is not used, the default network is sent
+		policies.AllowedNetwork = &kp.AllowedNetworkPolicyData{Enabled: false, Network: "public-and-private"}
+	}
+	if disable("key_create_import_access") {
+		policies.KeyCreateImportAccess = &kp.KeyCreateImportAccessInstancePolicy{
+			Enabled:           false,
+			CreateRootKey:     true,
+			CreateStandardKey: true,
+			ImportRootKey:     true,
+			ImportStandardKey: true,
+		}
+	}
+	return policies
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSInstancePolicies_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsInstancePoliciesConfig(instanceName, true, "public-and-private"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "dual_auth_delete.0.enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "metrics.0.enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "allowed_network.0.network", "public-and-private"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "key_create_import_access.0.enforce_token", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsInstancePoliciesConfig(instanceName, false, "private-only"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "dual_auth_delete.0.enabled", "false"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "allowed_network.0.network", "private-only"),
+				),
+			},
+			{
+				ResourceName:      "ibm_kms_instance_policies.policies",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsInstancePoliciesConfig(instanceName string, dualAuthDelete bool, network string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_instance_policies" "policies" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		dual_auth_delete {
+			enabled = %t
+		}
+		metrics {
+			enabled = true
+		}
+		allowed_network {
+			enabled = true
+			network = "%s"
+		}
+		key_create_import_access {
+			enabled       = true
+			enforce_token = true
+		}
+	}
+`, instanceName, dualAuthDelete, network)
+}
@@ -6,6 +6,7 @@ package kms
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,6 +30,18 @@ func suppressKMSInstanceIDDiff(k, old, new string, d *schema.ResourceData) bool
 	return false
 }
 
+// kmsKeyStates maps the numeric key states of Key Protect and HPCS to the values of the key_state attribute
+var kmsKeyStates = map[int]string{
+	0: "pre_activation",
+	1: "active",
+	2: "suspended",
+	3: "deactivated",
+	5: "destroyed",
+}
+
+// Keys can be restored within 30 days of their deletion
+const kmsKeyRestoreWindow = 30 * 24 * time.Hour
+
 func ResourceIBMKmskey() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMKmsKeyCreate,
@@ -41,6 +54,7 @@ func ResourceIBMKmskey() *schema.Resource {
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
 		},
+		CustomizeDiff: resourceIBMKmsKeyCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -89,10 +103,11 @@ func ResourceIBMKmskey() *schema.Resource {
 				Description: "Standard key type",
 			},
 			"payload": {
-				Type:     schema.TypeString,
-				Computed: true,
-				Optional: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				DiffSuppressFunc: flex.SuppressSaltedHashedSecret,
+				Description:      "The base64 encoded key material to import. The imported key material is not stored in state, only its hash. Changing it together with rotate_trigger rotates the root key to the new key material, otherwise it forces a new key.",
 			},
 			"encrypted_nonce": {
 				Type:        schema.TypeString,
@@ -113,6 +128,47 @@ func ResourceIBMKmskey() *schema.Resource {
 				ForceNew:    false,
 				Default:     false,
 			},
+			"key_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"active", "suspended", "set_for_deletion"}),
+				Description:  "The state of the key: active, suspended or set_for_deletion. Setting active restores a destroyed key, enables a suspended key and cancels a deletion request.",
+			},
+			"rotate_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Any change of the value rotates the root key, using payload as the new key material of an imported root key.",
+			},
+			"registrations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The cloud resources that are registered to the key.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the cloud resource that uses the key.",
+						},
+						"prevent_key_deletion": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the registration prevents the deletion of the key.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the registration.",
+						},
+					},
+				},
+			},
+			"last_rotate_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the key was last rotated.",
+			},
 			"crn": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -257,6 +313,10 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 			d.SetId(keyCRN)
 		}
 	}
+	// the imported key material is write-only, only its hash is kept in state
+	if err := flex.SetHashedSecret(d, "payload"); err != nil {
+		return err
+	}
 	return resourceIBMKmsKeyUpdate(d, meta)
 }
 
@@ -303,14 +363,28 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 		}
 		return fmt.Errorf("[ERROR] Get Key failed with error while reading Key: %s", err)
 	} else if key.State == 5 { //Refers to Deleted state of the Key
-		d.SetId("")
-		return nil
+		// keep a key that can still be restored, so that it is either restored or replaced
+		if key.DeletionDate == nil || time.Since(*key.DeletionDate) > kmsKeyRestoreWindow {
+			d.SetId("")
+			return nil
+		}
 	}
 	d.Set("instance_id", instanceID)
 	d.Set("instance_crn", instanceCRN)
 	d.Set("key_id", keyid)
 	d.Set("standard_key", key.Extractable)
-	d.Set("payload", key.Payload)
+	// only the hash of imported key material is kept in state, the key material of a generated standard key is stored as returned
+	if current := d.Get("payload").(string); flex.IsHashedSecret(current) {
+		if key.Payload != "" {
+			payload, err := flex.HashedSecretState(current, key.Payload)
+			if err != nil {
+				return err
+			}
+			d.Set("payload", payload)
+		}
+	} else {
+		d.Set("payload", key.Payload)
+	}
 	d.Set("encrypted_nonce", key.EncryptedNonce)
 	d.Set("iv_value", key.IV)
 	d.Set("key_name", key.Name)
@@ -325,6 +399,21 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("force_delete", d.Get("force_delete").(bool))
 	}
 	d.Set("key_ring_id", key.KeyRingID)
+	// a deletion request is not returned with the key, it is kept while the key stays active
+	if keyState := kmsKeyStates[key.State]; keyState != "active" || d.Get("key_state").(string) != "set_for_deletion" {
+		d.Set("key_state", keyState)
+	}
+	if key.LastRotateDate != nil {
+		d.Set("last_rotate_date", key.LastRotateDate.Format(time.RFC3339))
+	}
+	if key.State != 5 {
+		registrations, err := kpAPI.ListRegistrations(context.Background(), keyid, "")
+		if err != nil {
+			log.Printf("[WARN] Error listing the registrations of key %s: %s", keyid, err)
+		} else {
+			d.Set("registrations", flattenKmsKeyRegistrations(registrations.Registrations))
+		}
+	}
 	if key.Expiration != nil {
 		expiration := key.Expiration
 		d.Set("expiration_date", expiration.Format(time.RFC3339))
@@ -353,42 +442,131 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("force_delete") {
 		d.Set("force_delete", d.Get("force_delete").(bool))
 	}
+	// key_state is computed, only a configured state is applied
+	keyStateSet := !d.GetRawConfig().GetAttr("key_state").IsNull()
+	rotate := !d.IsNewResource() && d.HasChange("rotate_trigger")
+	if keyStateSet || rotate {
+		kpAPI, keyid, err := kmsKeyAPI(d, meta)
+		if err != nil {
+			return err
+		}
+		if keyStateSet {
+			if err = updateKmsKeyState(kpAPI, d, keyid); err != nil {
+				return err
+			}
+		}
+		if rotate {
+			// only imported root keys are rotated with new key material
+			var payload string
+			if d.HasChange("payload") {
+				payload = d.Get("payload").(string)
+			}
+			if err = kpAPI.Rotate(context.Background(), keyid, payload); err != nil {
+				return fmt.Errorf("[ERROR] Error while rotating the key: %s", err)
+			}
+			if err = flex.SetHashedSecret(d, "payload"); err != nil {
+				return err
+			}
+		}
+	}
 	return resourceIBMKmsKeyRead(d, meta)
 
 }
 
-func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	kpAPI, err := meta.(conns.ClientSession).KeyManagementAPI()
+// updateKmsKeyState moves the key to the configured key_state
+func updateKmsKeyState(kpAPI *kp.Client, d *schema.ResourceData, keyid string) error {
+	key, err := kpAPI.GetKey(context.Background(), keyid)
 	if err != nil {
-		return err
+		return fmt.Errorf("[ERROR] Get Key failed with error while updating the key state: %s", err)
+	}
+	currentState := kmsKeyStates[key.State]
+	oldState, _ := d.GetChange("key_state")
+	setForDeletion := oldState.(string) == "set_for_deletion"
+
+	switch d.Get("key_state").(string) {
+	case "active":
+		switch {
+		case currentState == "destroyed":
+			_, err = kpAPI.RestoreKey(context.Background(), keyid)
+		case currentState == "suspended":
+			err = kpAPI.EnableKey(context.Background(), keyid)
+		case setForDeletion:
+			err = kpAPI.CancelDualAuthDelete(context.Background(), keyid)
+		}
+	case "suspended":
+		if setForDeletion {
+			if err = kpAPI.CancelDualAuthDelete(context.Background(), keyid); err != nil {
+				break
+			}
+		}
+		if currentState == "active" {
+			err = kpAPI.DisableKey(context.Background(), keyid)
+		}
+	case "set_for_deletion":
+		if !setForDeletion {
+			err = kpAPI.InitiateDualAuthDelete(context.Background(), keyid)
+		}
+	}
+	if err == nil && (currentState == "deactivated" || currentState == "pre_activation") && d.Get("key_state").(string) != currentState {
+		err = fmt.Errorf("a %s key can not be moved to another state", currentState)
 	}
-	crn := d.Id()
-	crnData := strings.Split(crn, ":")
-	endpointType := d.Get("endpoint_type").(string)
-	instanceID := crnData[len(crnData)-3]
-	keyid := crnData[len(crnData)-1]
-
-	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return fmt.Errorf("[ERROR] Error while changing the state of the key from %s to %s: %s", currentState, d.Get("key_state").(string), err)
 	}
-	resourceInstanceGet := rc.GetResourceInstanceOptions{
-		ID: &instanceID,
+	return nil
+}
+
+func resourceIBMKmsKeyCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	oldState, _ := diff.GetChange("key_state")
+	if oldState.(string) == "destroyed" && diff.Get("key_state").(string) != "active" {
+		// a destroyed key is replaced unless it is restored
+		if err := diff.SetNewComputed("key_state"); err != nil {
+			return err
+		}
+		return diff.ForceNew("key_state")
+	}
+	// Key Protect moves a key out of these states by itself, at its activation or expiration date
+	if (oldState.(string) == "deactivated" || oldState.(string) == "pre_activation") && !diff.GetRawConfig().GetAttr("key_state").IsNull() &&
+		diff.Get("key_state").(string) != oldState.(string) {
+		return fmt.Errorf("[ERROR] The key is %s and can not be moved to key_state %s", oldState.(string), diff.Get("key_state").(string))
 	}
+	if diff.HasChange("rotate_trigger") && diff.Get("standard_key").(bool) {
+		return fmt.Errorf("[ERROR] rotate_trigger is only supported for root keys")
+	}
+	if diff.HasChange("payload") && !diff.HasChange("rotate_trigger") {
+		return diff.ForceNew("payload")
+	}
+	return nil
+}
 
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
-	if err != nil || instanceData == nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	if d.Get("key_state").(string) == "destroyed" {
+		d.SetId("")
+		return nil
 	}
-	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+
+	kpAPI, keyid, err := kmsKeyAPI(d, meta)
 	if err != nil {
 		return err
 	}
-	kpAPI.URL = URL
-	kpAPI.Config.InstanceID = instanceID
 
 	force := d.Get("force_delete").(bool)
+	if !force {
+		registrations, err := kpAPI.ListRegistrations(context.Background(), keyid, "")
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing the registrations of the key: %s", err)
+		}
+		if len(registrations.Registrations) > 0 {
+			resourceCRNs := make([]string, 0, len(registrations.Registrations))
+			for _, registration := range registrations.Registrations {
+				resourceCRNs = append(resourceCRNs, registration.ResourceCrn)
+			}
+			return fmt.Errorf("[ERROR] The key is in use by %s, set force_delete to delete it", strings.Join(resourceCRNs, ", "))
+		}
+	}
 	f := kp.ForceOpt{
 		Force: force,
 	}
@@ -446,6 +624,62 @@ func resourceIBMKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, er
 
 }
 
+func flattenKmsKeyRegistrations(registrations []kp.Registration) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(registrations))
+	for _, registration := range registrations {
+		result = append(result, map[string]interface{}{
+			"resource_crn":         registration.ResourceCrn,
+			"prevent_key_deletion": registration.PreventKeyDeletion,
+			"description":          registration.Description,
+		})
+	}
+	return result
+}
+
+// kmsKeyAPI returns the client of the instance of the key and the key ID
+func kmsKeyAPI(d *schema.ResourceData, meta interface{}) (*kp.Client, string, error) {
+	crnData := strings.Split(d.Id(), ":")
+	instanceID := crnData[len(crnData)-3]
+	keyid := crnData[len(crnData)-1]
+
+	kpAPI, _, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return nil, "", err
+	}
+	return kpAPI, keyid, nil
+}
+
+// kmsInstanceAPI returns the client of a Key Protect or HPCS instance and the CRN of the instance
+func kmsInstanceAPI(meta interface{}, instanceID, endpointType string) (*kp.Client, string, error) {
+	kpAPI, err := meta.(conns.ClientSession).KeyManagementAPI()
+	if err != nil {
+		return nil, "", err
+	}
+	CrnInstanceID := strings.Split(instanceID, ":")
+	if len(CrnInstanceID) > 3 {
+		instanceID = CrnInstanceID[len(CrnInstanceID)-3]
+	}
+
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, "", err
+	}
+	resourceInstanceGet := rc.GetResourceInstanceOptions{
+		ID: &instanceID,
+	}
+	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	if err != nil || instanceData == nil {
+		return nil, "", fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+	}
+	URL, err := KmsEndpointURL(kpAPI, endpointType, instanceData.Extensions)
+	if err != nil {
+		return nil, "", err
+	}
+	kpAPI.URL = URL
+	kpAPI.Config.InstanceID = instanceID
+	return kpAPI, *instanceData.CRN, nil
+}
+
 //Construct KMS URL
 func KmsEndpointURL(kpAPI *kp.Client, endpointType string, extensions map[string]interface{}) (*url.URL, error) {
 
@@ -126,6 +126,44 @@ func TestAccIBMKMSResource_InvalidExpDate(t *testing.T) {
 	})
 }
 
+// Test for rotating, suspending and enabling a root key
+func TestAccIBMKMSResource_KeyStateAndRotation(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKeyStateConfig(instanceName, keyName, "active", "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_state", "active"),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "registrations.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsKeyStateConfig(instanceName, keyName, "active", "incident-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_kms_key.test", "last_rotate_date"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsKeyStateConfig(instanceName, keyName, "suspended", "incident-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_state", "suspended"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsKeyStateConfig(instanceName, keyName, "active", "incident-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_state", "active"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMKmsResourceStandardConfig(instanceName, KeyName string) string {
 	return fmt.Sprintf(`
 	resource "ibm_resource_instance" "kms_instance" {
@@ -318,3 +356,22 @@ func testAccCheckIBMKmsKeyPolicyDualAuth(instanceName, KeyName string, dual_auth
 	  }
 `, instanceName, KeyName, dual_auth_delete)
 }
+
+func testAccCheckIBMKmsKeyStateConfig(instanceName, KeyName, keyState, rotateTrigger string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	  }
+	  resource "ibm_kms_key" "test" {
+		instance_id = "${ibm_resource_instance.kms_instance.guid}"
+		key_name = "%s"
+		standard_key =  false
+		force_delete = true
+		key_state = "%s"
+		rotate_trigger = "%s"
+	}
+`, instanceName, KeyName, keyState, rotateTrigger)
+}
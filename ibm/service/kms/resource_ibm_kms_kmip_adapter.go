@@ -0,0 +1,313 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	kmipAdapterCollectionType      = "application/vnd.ibm.kms.kmip_adapter+json"
+	kmipClientCertCollectionType   = "application/vnd.ibm.kms.kmip_client_certificate+json"
+	kmipAdapterProfileNative       = "native_1.0"
+	kmipAdapterIDSeparator         = ":kmip_adapter:"
+	kmipClientCertificateSeparator = ":kmip_client_cert:"
+)
+
+// kmipCollectionMetadata is the metadata of the KMIP collections sent to the API
+type kmipCollectionMetadata struct {
+	CollectionType  string `json:"collectionType"`
+	CollectionTotal int    `json:"collectionTotal"`
+}
+
+type kmipAdapter struct {
+	ID          string                 `json:"id,omitempty"`
+	Profile     string                 `json:"profile,omitempty"`
+	ProfileData map[string]interface{} `json:"profile_data,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	CreatedBy   string                 `json:"created_by,omitempty"`
+	CreatedAt   *time.Time             `json:"created_at,omitempty"`
+	UpdatedBy   string                 `json:"updated_by,omitempty"`
+	UpdatedAt   *time.Time             `json:"updated_at,omitempty"`
+}
+
+type kmipAdapters struct {
+	Metadata kmipCollectionMetadata `json:"metadata"`
+	Adapters []kmipAdapter          `json:"resources"`
+}
+
+func ResourceIBMKmsKmipAdapter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKmipAdapterCreate,
+		ReadContext:   resourceIBMKmsKmipAdapterRead,
+		DeleteContext: resourceIBMKmsKmipAdapterDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect Instance GUID",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the KMIP adapter. A name is generated when not set.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The description of the KMIP adapter.",
+			},
+			"profile": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      kmipAdapterProfileNative,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{kmipAdapterProfileNative}),
+				Description:  "The profile of the KMIP adapter.",
+			},
+			"crk_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the root key the KMIP adapter uses to wrap the keys it manages.",
+			},
+			"adapter_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the KMIP adapter.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the KMIP adapter was created.",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the user that created the KMIP adapter.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the KMIP adapter was last updated.",
+			},
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the user that last updated the KMIP adapter.",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKmipAdapterCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, instanceCRN, err := kmsInstanceAPI(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	adapter := kmipAdapter{
+		Profile: d.Get("profile").(string),
+		ProfileData: map[string]interface{}{
+			"crk_id": d.Get("crk_id").(string),
+		},
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+	request := kmipAdapters{
+		Metadata: kmipCollectionMetadata{CollectionType: kmipAdapterCollectionType, CollectionTotal: 1},
+		Adapters: []kmipAdapter{adapter},
+	}
+	response := kmipAdapters{}
+	err = kmipRequest(context, meta, kpAPI, http.MethodPost, "kmip_adapters", request, &response)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while creating KMIP adapter: %s", err))
+	}
+	if len(response.Adapters) == 0 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while creating KMIP adapter: the response does not contain the adapter"))
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", response.Adapters[0].ID, kmipAdapterIDSeparator, instanceCRN))
+
+	return resourceIBMKmsKmipAdapterRead(context, d, meta)
+}
+
+func resourceIBMKmsKmipAdapterRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adapterID, instanceID, err := parseKmipAdapterID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	kpAPI, _, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response := kmipAdapters{}
+	err = kmipRequest(context, meta, kpAPI, http.MethodGet, "kmip_adapters/"+adapterID, nil, &response)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && kpError.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Get KMIP adapter failed with error: %s", err))
+	}
+	if len(response.Adapters) == 0 {
+		d.SetId("")
+		return nil
+	}
+	adapter := response.Adapters[0]
+
+	d.Set("instance_id", instanceID)
+	if strings.Contains((kpAPI.URL).String(), "private") || strings.Contains(kpAPI.Config.BaseURL, "private") {
+		d.Set("endpoint_type", "private")
+	} else {
+		d.Set("endpoint_type", "public")
+	}
+	d.Set("adapter_id", adapter.ID)
+	d.Set("name", adapter.Name)
+	d.Set("description", adapter.Description)
+	d.Set("profile", adapter.Profile)
+	if crkID, ok := adapter.ProfileData["crk_id"].(string); ok {
+		d.Set("crk_id", crkID)
+	}
+	d.Set("created_by", adapter.CreatedBy)
+	d.Set("updated_by", adapter.UpdatedBy)
+	if adapter.CreatedAt != nil {
+		d.Set("created_at", adapter.CreatedAt.Format(time.RFC3339))
+	}
+	if adapter.UpdatedAt != nil {
+		d.Set("updated_at", adapter.UpdatedAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceIBMKmsKmipAdapterDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adapterID, instanceID, err := parseKmipAdapterID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	kpAPI, _, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = kmipRequest(context, meta, kpAPI, http.MethodDelete, "kmip_adapters/"+adapterID, nil, nil)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); !ok || kpError.StatusCode != 404 {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error while deleting KMIP adapter: %s", err))
+		}
+	}
+	d.SetId("")
+
+	return nil
+}
+
+// parseKmipAdapterID returns the adapter ID and the instance GUID of a KMIP adapter resource ID
+func parseKmipAdapterID(id string) (string, string, error) {
+	parts := strings.Split(id, kmipAdapterIDSeparator)
+	if len(parts) < 2 {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of adapterID%sInstanceCRN", id, kmipAdapterIDSeparator)
+	}
+	crnData := strings.Split(parts[1], ":")
+	if len(crnData) < 3 {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: %s is not an instance CRN", id, parts[1])
+	}
+	return parts[0], crnData[len(crnData)-3], nil
+}
+
+// kmipRequest calls the KMIP API of the instance, which the Key Protect SDK does not provide.
+// The request uses the endpoint and the HTTP client of kpAPI and returns a *kp.Error for error responses.
+func kmipRequest(context context.Context, meta interface{}, kpAPI *kp.Client, method, path string, body, result interface{}) error {
+	authorization, err := kmipAuthorization(meta, kpAPI)
+	if err != nil {
+		return err
+	}
+	requestURL, err := kpAPI.URL.Parse(path)
+	if err != nil {
+		return err
+	}
+	var requestBody io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		requestBody = bytes.NewBuffer(payload)
+	}
+	request, err := http.NewRequestWithContext(context, method, requestURL.String(), requestBody)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("accept", "application/json")
+	request.Header.Set("content-type", "application/json")
+	request.Header.Set("bluemix-instance", kpAPI.Config.InstanceID)
+	request.Header.Set("authorization", authorization)
+
+	response, err := kpAPI.HttpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode >= 300 {
+		return &kp.Error{
+			URL:         requestURL.String(),
+			StatusCode:  response.StatusCode,
+			Message:     string(responseBody),
+			BodyContent: responseBody,
+		}
+	}
+	if result != nil && len(responseBody) != 0 {
+		return json.Unmarshal(responseBody, result)
+	}
+	return nil
+}
+
+// kmipAuthorization returns the authorization the kp client sends, its access token or the IAM token of the provider session
+func kmipAuthorization(meta interface{}, kpAPI *kp.Client) (string, error) {
+	if kpAPI.Config.Authorization != "" {
+		return kpAPI.Config.Authorization, nil
+	}
+	sess, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return "", err
+	}
+	if sess.Config.IAMAccessToken == "" {
+		return "", fmt.Errorf("[ERROR] Error getting the IAM token of the KMIP request: the provider session is not authenticated")
+	}
+	return sess.Config.IAMAccessToken, nil
+}
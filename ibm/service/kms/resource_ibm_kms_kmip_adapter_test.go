@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKmipAdapter_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	adapterName := fmt.Sprintf("kmip-%d", acctest.RandIntRange(10, 100))
+	certificate := testAccIBMKmsKmipClientCertificate(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKmipAdapterConfig(instanceName, keyName, adapterName, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_kmip_adapter.adapter", "name", adapterName),
+					resource.TestCheckResourceAttr("ibm_kms_kmip_adapter.adapter", "profile", "native_1.0"),
+					resource.TestCheckResourceAttrPair("ibm_kms_kmip_adapter.adapter", "crk_id", "ibm_kms_key.test", "key_id"),
+					resource.TestCheckResourceAttrPair("ibm_kms_kmip_client_cert.cert", "adapter_id", "ibm_kms_kmip_adapter.adapter", "adapter_id"),
+					resource.TestCheckResourceAttrSet("ibm_kms_kmip_client_cert.cert", "cert_id"),
+				),
+			},
+			{
+				ResourceName:      "ibm_kms_kmip_adapter.adapter",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKmipAdapterConfig(instanceName, keyName, adapterName, certificate string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kms_instance.guid
+		key_name     = "%s"
+		standard_key = false
+		force_delete = true
+	}
+	resource "ibm_kms_kmip_adapter" "adapter" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		name        = "%s"
+		crk_id      = ibm_kms_key.test.key_id
+	}
+	resource "ibm_kms_kmip_client_cert" "cert" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		adapter_id  = ibm_kms_kmip_adapter.adapter.adapter_id
+		name        = "appliance"
+		certificate = <<EOT
+%sEOT
+	}
+`, instanceName, keyName, adapterName, certificate)
+}
+
+func testAccIBMKmsKmipClientCertificate(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "kmip-client"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().AddDate(1, 0, 0),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type kmipClientCertificate struct {
+	ID          string     `json:"id,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	Certificate string     `json:"certificate,omitempty"`
+	CreatedBy   string     `json:"created_by,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+}
+
+type kmipClientCertificates struct {
+	Metadata     kmipCollectionMetadata  `json:"metadata"`
+	Certificates []kmipClientCertificate `json:"resources"`
+}
+
+func ResourceIBMKmsKmipClientCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKmipClientCertificateCreate,
+		ReadContext:   resourceIBMKmsKmipClientCertificateRead,
+		DeleteContext: resourceIBMKmsKmipClientCertificateDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect Instance GUID",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+			},
+			"adapter_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the KMIP adapter the client certificate is added to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the client certificate. A name is generated when not set.",
+			},
+			"certificate": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentPEM,
+				Description:      "The PEM encoded certificate the KMIP client authenticates with.",
+			},
+			"cert_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the client certificate.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the client certificate was added.",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the user that added the client certificate.",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKmipClientCertificateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, instanceCRN, err := kmsInstanceAPI(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	adapterID := d.Get("adapter_id").(string)
+	request := kmipClientCertificates{
+		Metadata: kmipCollectionMetadata{CollectionType: kmipClientCertCollectionType, CollectionTotal: 1},
+		Certificates: []kmipClientCertificate{
+			{
+				Name:        d.Get("name").(string),
+				Certificate: d.Get("certificate").(string),
+			},
+		},
+	}
+	response := kmipClientCertificates{}
+	err = kmipRequest(context, meta, kpAPI, http.MethodPost, fmt.Sprintf("kmip_adapters/%s/certificates", adapterID), request, &response)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while adding KMIP client certificate: %s", err))
+	}
+	if len(response.Certificates) == 0 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while adding KMIP client certificate: the response does not contain the certificate"))
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s%s%s", response.Certificates[0].ID, kmipClientCertificateSeparator, adapterID, kmipAdapterIDSeparator, instanceCRN))
+
+	return resourceIBMKmsKmipClientCertificateRead(context, d, meta)
+}
+
+func resourceIBMKmsKmipClientCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	certID, adapterID, instanceID, err := parseKmipClientCertificateID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	kpAPI, _, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response := kmipClientCertificates{}
+	err = kmipRequest(context, meta, kpAPI, http.MethodGet, fmt.Sprintf("kmip_adapters/%s/certificates/%s", adapterID, certID), nil, &response)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && kpError.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Get KMIP client certificate failed with error: %s", err))
+	}
+	if len(response.Certificates) == 0 {
+		d.SetId("")
+		return nil
+	}
+	certificate := response.Certificates[0]
+
+	d.Set("instance_id", instanceID)
+	if strings.Contains((kpAPI.URL).String(), "private") || strings.Contains(kpAPI.Config.BaseURL, "private") {
+		d.Set("endpoint_type", "private")
+	} else {
+		d.Set("endpoint_type", "public")
+	}
+	d.Set("adapter_id", adapterID)
+	d.Set("cert_id", certificate.ID)
+	d.Set("name", certificate.Name)
+	if certificate.Certificate != "" {
+		d.Set("certificate", certificate.Certificate)
+	}
+	d.Set("created_by", certificate.CreatedBy)
+	if certificate.CreatedAt != nil {
+		d.Set("created_at", certificate.CreatedAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceIBMKmsKmipClientCertificateDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	certID, adapterID, instanceID, err := parseKmipClientCertificateID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	kpAPI, _, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = kmipRequest(context, meta, kpAPI, http.MethodDelete, fmt.Sprintf("kmip_adapters/%s/certificates/%s", adapterID, certID), nil, nil)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); !ok || kpError.StatusCode != 404 {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error while deleting KMIP client certificate: %s", err))
+		}
+	}
+	d.SetId("")
+
+	return nil
+}
+
+// suppressEquivalentPEM ignores differences in the line breaks and headers of the same PEM encoded certificate
+func suppressEquivalentPEM(k, old, new string, d *schema.ResourceData) bool {
+	oldBlock, _ := pem.Decode([]byte(strings.TrimSpace(old)))
+	newBlock, _ := pem.Decode([]byte(strings.TrimSpace(new)))
+	if oldBlock == nil || newBlock == nil {
+		return false
+	}
+	return oldBlock.Type == newBlock.Type && bytes.Equal(oldBlock.Bytes, newBlock.Bytes)
+}
+
+// parseKmipClientCertificateID returns the certificate ID, the adapter ID and the instance GUID of a KMIP client certificate resource ID
+func parseKmipClientCertificateID(id string) (string, string, string, error) {
+	parts := strings.Split(id, kmipClientCertificateSeparator)
+	if len(parts) < 2 {
+		return "", "", "", fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of certificateID%sadapterID%sInstanceCRN", id, kmipClientCertificateSeparator, kmipAdapterIDSeparator)
+	}
+	adapterID, instanceID, err := parseKmipAdapterID(parts[1])
+	if err != nil {
+		return "", "", "", err
+	}
+	return parts[0], adapterID, instanceID, nil
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKmipClientCert_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	adapterName := fmt.Sprintf("kmip-%d", acctest.RandIntRange(10, 100))
+	certificate := testAccIBMKmsKmipClientCertificate(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKmipAdapterConfig(instanceName, keyName, adapterName, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_kmip_client_cert.cert", "name", "appliance"),
+					resource.TestCheckResourceAttrPair("ibm_kms_kmip_client_cert.cert", "adapter_id", "ibm_kms_kmip_adapter.adapter", "adapter_id"),
+					resource.TestCheckResourceAttrSet("ibm_kms_kmip_client_cert.cert", "cert_id"),
+					resource.TestCheckResourceAttrSet("ibm_kms_kmip_client_cert.cert", "created_at"),
+				),
+			},
+			{
+				// the same certificate without the trailing line break does not replace the resource
+				Config:   testAccCheckIBMKmsKmipClientCertTrimmedConfig(instanceName, keyName, adapterName, certificate),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            "ibm_kms_kmip_client_cert.cert",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"certificate"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKmipClientCertTrimmedConfig(instanceName, keyName, adapterName, certificate string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kms_instance.guid
+		key_name     = "%s"
+		standard_key = false
+		force_delete = true
+	}
+	resource "ibm_kms_kmip_adapter" "adapter" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		name        = "%s"
+		crk_id      = ibm_kms_key.test.key_id
+	}
+	resource "ibm_kms_kmip_client_cert" "cert" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		adapter_id  = ibm_kms_kmip_adapter.adapter.adapter_id
+		name        = "appliance"
+		certificate = trimspace(<<EOT
+%sEOT
+		)
+	}
+`, instanceName, keyName, adapterName, certificate)
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func DataSourceIBMPISharedProcessorPool() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPISharedProcessorPoolRead,
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "PI cloud instance ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_SharedProcessorPoolID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Shared processor pool ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_AllocatedCores: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Shared processor pool allocated cores",
+			},
+			Attr_AvailableCores: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Shared processor pool available cores",
+			},
+			Attr_HostID: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The host ID where the shared processor pool resides",
+			},
+			Attr_Instances: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of server instances deployed in the shared processor pool",
+				Elem:        sharedProcessorPoolInstanceSchema(),
+			},
+			Attr_Name: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the shared processor pool",
+			},
+			Attr_ReservedCores: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The amount of reserved cores for the shared processor pool",
+			},
+			Attr_Status: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the shared processor pool",
+			},
+			Attr_StatusDetail: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status details of the shared processor pool",
+			},
+		},
+	}
+}
+
+func dataSourceIBMPISharedProcessorPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	sppID := d.Get(Arg_SharedProcessorPoolID).(string)
+
+	client := newPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+	detail, err := client.Get(sppID)
+	if err != nil {
+		log.Printf("[ERROR] get shared processor pool failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	spp := detail.SharedProcessorPool
+	d.SetId(*spp.ID)
+	d.Set(Attr_AllocatedCores, spp.AllocatedCores)
+	d.Set(Attr_AvailableCores, spp.AvailableCores)
+	d.Set(Attr_HostID, spp.HostID)
+	d.Set(Attr_Instances, flattenSharedProcessorPoolInstances(detail.Servers))
+	d.Set(Attr_Name, spp.Name)
+	d.Set(Attr_ReservedCores, spp.ReservedCores)
+	d.Set(Attr_Status, spp.Status)
+	d.Set(Attr_StatusDetail, spp.StatusDetail)
+
+	return nil
+}
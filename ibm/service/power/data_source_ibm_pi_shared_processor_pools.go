@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func DataSourceIBMPISharedProcessorPools() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPISharedProcessorPoolsRead,
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "PI cloud instance ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_SharedProcessorPools: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of all the shared processor pools",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_AllocatedCores: {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Shared processor pool allocated cores",
+						},
+						Attr_AvailableCores: {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Shared processor pool available cores",
+						},
+						Attr_HostID: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The host ID where the shared processor pool resides",
+						},
+						Attr_Name: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the shared processor pool",
+						},
+						Attr_ReservedCores: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The amount of reserved cores for the shared processor pool",
+						},
+						Attr_SharedProcessorPoolID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The shared processor pool ID",
+						},
+						Attr_Status: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the shared processor pool",
+						},
+						Attr_StatusDetail: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status details of the shared processor pool",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMPISharedProcessorPoolsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+
+	client := newPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+	pools, err := client.GetAll()
+	if err != nil {
+		log.Printf("[ERROR] get all shared processor pools failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(pools.SharedProcessorPools))
+	for _, pool := range pools.SharedProcessorPools {
+		key := map[string]interface{}{
+			Attr_AllocatedCores:        *pool.AllocatedCores,
+			Attr_AvailableCores:        *pool.AvailableCores,
+			Attr_HostID:                pool.HostID,
+			Attr_Name:                  *pool.Name,
+			Attr_ReservedCores:         *pool.ReservedCores,
+			Attr_SharedProcessorPoolID: *pool.ID,
+			Attr_Status:                pool.Status,
+			Attr_StatusDetail:          pool.StatusDetail,
+		}
+		result = append(result, key)
+	}
+
+	var genID, _ = uuid.GenerateUUID()
+	d.SetId(genID)
+	d.Set(Attr_SharedProcessorPools, result)
+
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func DataSourceIBMPISPPPlacementGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPISPPPlacementGroupRead,
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "PI cloud instance ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_SPPPlacementGroupID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "SPP placement group ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_Members: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Shared processor pool IDs that are the SPP placement group members",
+			},
+			Attr_Name: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the SPP placement group",
+			},
+			Attr_Policy: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The policy of the SPP placement group",
+			},
+		},
+	}
+}
+
+func dataSourceIBMPISPPPlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	pgID := d.Get(Arg_SPPPlacementGroupID).(string)
+
+	client := newPISPPPlacementGroupClient(ctx, sess, cloudInstanceID)
+	pg, err := client.Get(pgID)
+	if err != nil {
+		log.Printf("[ERROR] get SPP placement group failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*pg.ID)
+	d.Set(Attr_Members, pg.MemberSharedProcessorPools)
+	d.Set(Attr_Name, pg.Name)
+	d.Set(Attr_Policy, pg.Policy)
+
+	return nil
+}
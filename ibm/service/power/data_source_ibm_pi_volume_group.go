@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func DataSourceIBMPIVolumeGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIVolumeGroupRead,
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "PI cloud instance ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_VolumeGroupID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Volume group ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_Name: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the volume group",
+			},
+			Attr_ConsistencyGroupName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the consistency group at storage controller level",
+			},
+			Attr_ReplicationStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The replication status of the volume group",
+			},
+			Attr_Status: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the volume group",
+			},
+			Attr_StatusDescriptionErrors: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The status details of the volume group",
+				Elem:        volumeGroupStatusDescriptionErrorSchema(),
+			},
+			Attr_VolumeIDs: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of volume IDs that are members of the volume group",
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIVolumeGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	vgID := d.Get(Arg_VolumeGroupID).(string)
+
+	client := newPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	vg, err := client.GetDetails(vgID)
+	if err != nil {
+		log.Printf("[ERROR] get volume group failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*vg.ID)
+	d.Set(Attr_Name, vg.Name)
+	d.Set(Attr_ConsistencyGroupName, vg.ConsistencyGroupName)
+	d.Set(Attr_ReplicationStatus, vg.ReplicationStatus)
+	d.Set(Attr_Status, vg.Status)
+	d.Set(Attr_StatusDescriptionErrors, flattenVolumeGroupStatusDescriptionErrors(vg.StatusDescription))
+	d.Set(Attr_VolumeIDs, vg.VolumeIDs)
+
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func DataSourceIBMPIVolumeGroupRemoteCopyRelationships() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIVolumeGroupRemoteCopyRelationshipsRead,
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "PI cloud instance ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_VolumeGroupID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Volume group ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_RemoteCopyRelationships: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of remote copy relationships of the volumes in the volume group",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_AuxVolumeName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The auxiliary volume name at storage host level",
+						},
+						Attr_ConsistencyGroupName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The consistency group name if the volume is a part of a volume group",
+						},
+						Attr_CopyType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The copy type",
+						},
+						Attr_CyclingMode: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of cycling mode used",
+						},
+						Attr_FreezeTime: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The freeze time of the remote copy relationship",
+						},
+						Attr_MasterVolumeName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The master volume name at storage host level",
+						},
+						Attr_Name: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The remote copy relationship name",
+						},
+						Attr_PrimaryRole: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Indicates whether the master or aux volume is playing the primary role",
+						},
+						Attr_Progress: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The relationship progress",
+						},
+						Attr_RemoteCopyID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The remote copy relationship ID",
+						},
+						Attr_State: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The relationship state",
+						},
+						Attr_Sync: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Indicates whether the relationship is synchronized",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIVolumeGroupRemoteCopyRelationshipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	vgID := d.Get(Arg_VolumeGroupID).(string)
+
+	client := newPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	rcr, err := client.GetVolumeGroupRemoteCopyRelationships(vgID)
+	if err != nil {
+		log.Printf("[ERROR] get volume group remote copy relationships failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(rcr.RemoteCopyRelationships))
+	for _, r := range rcr.RemoteCopyRelationships {
+		if r == nil {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			Attr_AuxVolumeName:        r.AuxVolumeName,
+			Attr_ConsistencyGroupName: r.ConsistencyGroupName,
+			Attr_CopyType:             r.CopyType,
+			Attr_CyclingMode:          r.CyclingMode,
+			Attr_FreezeTime:           r.FreezeTime.String(),
+			Attr_MasterVolumeName:     r.MasterVolumeName,
+			Attr_Name:                 r.Name,
+			Attr_PrimaryRole:          r.PrimaryRole,
+			Attr_Progress:             r.Progress,
+			Attr_RemoteCopyID:         r.RemoteCopyID,
+			Attr_State:                r.State,
+			Attr_Sync:                 r.Sync,
+		})
+	}
+
+	d.SetId(vgID)
+	d.Set(Attr_RemoteCopyRelationships, result)
+
+	return nil
+}
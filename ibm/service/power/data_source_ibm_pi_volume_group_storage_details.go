@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func DataSourceIBMPIVolumeGroupStorageDetails() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIVolumeGroupStorageDetailsRead,
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "PI cloud instance ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_VolumeGroupID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Volume group ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_ConsistencyGroupName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the consistency group at storage controller level",
+			},
+			Attr_CyclePeriodSeconds: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The minimum period in seconds between multiple cycles",
+			},
+			Attr_CyclingMode: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of cycling mode used",
+			},
+			Attr_NumOfVols: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of volumes in the volume group",
+			},
+			Attr_PrimaryRole: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Indicates whether the master or aux volume is playing the primary role",
+			},
+			Attr_RemoteCopyRelationshipNames: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of remote copy relationship names in the volume group",
+			},
+			Attr_ReplicationType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of replication (metro, global)",
+			},
+			Attr_State: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The relationship state",
+			},
+			Attr_Sync: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Indicates whether the relationship is synchronized",
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIVolumeGroupStorageDetailsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	vgID := d.Get(Arg_VolumeGroupID).(string)
+
+	client := newPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	vgsd, err := client.GetVolumeGroupStorageDetails(vgID)
+	if err != nil {
+		log.Printf("[ERROR] get volume group storage details failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(vgID)
+	d.Set(Attr_ConsistencyGroupName, vgsd.ConsistencyGroupName)
+	d.Set(Attr_CyclePeriodSeconds, vgsd.CyclePeriodSeconds)
+	d.Set(Attr_CyclingMode, vgsd.CyclingMode)
+	d.Set(Attr_NumOfVols, vgsd.NumOfvols)
+	d.Set(Attr_PrimaryRole, vgsd.PrimaryRole)
+	d.Set(Attr_RemoteCopyRelationshipNames, vgsd.RemoteCopyRelationshipNames)
+	d.Set(Attr_ReplicationType, vgsd.ReplicationType)
+	d.Set(Attr_State, vgsd.State)
+	d.Set(Attr_Sync, vgsd.Sync)
+
+	return nil
+}
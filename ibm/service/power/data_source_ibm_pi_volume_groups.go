@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func DataSourceIBMPIVolumeGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIVolumeGroupsRead,
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "PI cloud instance ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_VolumeGroups: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of all the volume groups",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the volume group",
+						},
+						Attr_Name: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the volume group",
+						},
+						Attr_ConsistencyGroupName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the consistency group at storage controller level",
+						},
+						Attr_ReplicationStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The replication status of the volume group",
+						},
+						Attr_Status: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the volume group",
+						},
+						Attr_StatusDescriptionErrors: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The status details of the volume group",
+							Elem:        volumeGroupStatusDescriptionErrorSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIVolumeGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+
+	client := newPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	vgs, err := client.GetAll()
+	if err != nil {
+		log.Printf("[ERROR] get all volume groups failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(vgs.VolumeGroups))
+	for _, vg := range vgs.VolumeGroups {
+		if vg == nil {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"id":                         vg.ID,
+			Attr_Name:                    vg.Name,
+			Attr_ConsistencyGroupName:    vg.ConsistencyGroupName,
+			Attr_ReplicationStatus:       vg.ReplicationStatus,
+			Attr_Status:                  vg.Status,
+			Attr_StatusDescriptionErrors: flattenVolumeGroupStatusDescriptionErrors(vg.StatusDescription),
+		})
+	}
+
+	var genID, _ = uuid.GenerateUUID()
+	d.SetId(genID)
+	d.Set(Attr_VolumeGroups, result)
+
+	return nil
+}
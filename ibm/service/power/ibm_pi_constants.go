@@ -52,6 +52,11 @@ const (
 	PISAPInstanceProfileID        = "pi_sap_profile_id"
 	PISAPInstanceDeploymentType   = "pi_sap_deployment_type"
 	PIInstanceStoragePoolAffinity = "pi_storage_pool_affinity"
+	Attr_InstanceResizeMode       = "resize_mode"
+
+	// resize modes reported in the plan for memory and processor changes
+	ResizeModeDLPAR    = "dlpar"
+	ResizeModeShutdown = "shutdown"
 
 	// Placement Group
 	PIPlacementGroupID      = "placement_group_id"
@@ -64,6 +69,96 @@ const (
 	PIAntiAffinityInstances = "pi_anti_affinity_instances"
 	PIAntiAffinityVolumes   = "pi_anti_affinity_volumes"
 
+	// Volume replication
+	Arg_ReplicationEnabled = "pi_replication_enabled"
+
+	Attr_AuxVolumeName     = "auxiliary_volume_name"
+	Attr_GroupID           = "group_id"
+	Attr_MasterVolumeName  = "master_volume_name"
+	Attr_MirroringState    = "mirroring_state"
+	Attr_ReplicationStatus = "replication_status"
+	Attr_ReplicationType   = "replication_type"
+
+	// Volume Group
+	Arg_ConsistencyGroupName = "pi_consistency_group_name"
+	Arg_VolumeGroupAction    = "pi_volume_group_action"
+	Arg_VolumeGroupID        = "pi_volume_group_id"
+	Arg_VolumeGroupName      = "pi_volume_group_name"
+	Arg_VolumeIDs            = "pi_volume_ids"
+
+	Attr_Access                      = "access"
+	Attr_ConsistencyGroupName        = "consistency_group_name"
+	Attr_CopyType                    = "copy_type"
+	Attr_CyclePeriodSeconds          = "cycle_period_seconds"
+	Attr_CyclingMode                 = "cycling_mode"
+	Attr_FreezeTime                  = "freeze_time"
+	Attr_ErrorKey                    = "key"
+	Attr_Message                     = "message"
+	Attr_Name                        = "name"
+	Attr_NumOfVols                   = "number_of_volumes"
+	Attr_PrimaryRole                 = "primary_role"
+	Attr_Progress                    = "progress"
+	Attr_RemoteCopyID                = "remote_copy_id"
+	Attr_RemoteCopyRelationshipNames = "remote_copy_relationship_names"
+	Attr_RemoteCopyRelationships     = "remote_copy_relationships"
+	Attr_Reset                       = "reset"
+	Attr_Source                      = "source"
+	Attr_Start                       = "start"
+	Attr_State                       = "state"
+	Attr_Status                      = "status"
+	Attr_StatusDescriptionErrors     = "status_description_errors"
+	Attr_Stop                        = "stop"
+	Attr_Sync                        = "sync"
+	Attr_VolumeGroupID               = "volume_group_id"
+	Attr_VolumeGroupStatus           = "volume_group_status"
+	Attr_VolumeGroups                = "volume_groups"
+	Attr_VolumeIDs                   = "volume_ids"
+
+	// Shared Processor Pool
+	Arg_SharedProcessorPool                 = "pi_shared_processor_pool"
+	Arg_SharedProcessorPoolHostGroup        = "pi_shared_processor_pool_host_group"
+	Arg_SharedProcessorPoolID               = "pi_shared_processor_pool_id"
+	Arg_SharedProcessorPoolName             = "pi_shared_processor_pool_name"
+	Arg_SharedProcessorPoolPlacementGroupID = "pi_shared_processor_pool_placement_group_id"
+	Arg_SharedProcessorPoolReservedCores    = "pi_shared_processor_pool_reserved_cores"
+
+	Attr_AllocatedCores        = "allocated_cores"
+	Attr_AvailabilityZone      = "availability_zone"
+	Attr_AvailableCores        = "available_cores"
+	Attr_Cpus                  = "cpus"
+	Attr_HostGroup             = "host_group"
+	Attr_HostID                = "host_id"
+	Attr_ID                    = "id"
+	Attr_Instances             = "instances"
+	Attr_Memory                = "memory"
+	Attr_ReservedCores         = "reserved_cores"
+	Attr_SharedProcessorPoolID = "shared_processor_pool_id"
+	Attr_SharedProcessorPools  = "shared_processor_pools"
+	Attr_StatusDetail          = "status_detail"
+	Attr_Uncapped              = "uncapped"
+	Attr_Vcpus                 = "vcpus"
+
+	// SPP Placement Group
+	Arg_SPPPlacementGroupID     = "pi_spp_placement_group_id"
+	Arg_SPPPlacementGroupName   = "pi_spp_placement_group_name"
+	Arg_SPPPlacementGroupPolicy = "pi_spp_placement_group_policy"
+
+	Attr_Members             = "members"
+	Attr_Policy              = "policy"
+	Attr_SPPPlacementGroupID = "spp_placement_group_id"
+	Attr_SPPPlacementGroups  = "spp_placement_groups"
+
+	// Workspace
+	Arg_Datacenter      = "pi_datacenter"
+	Arg_Name            = "pi_name"
+	Arg_Plan            = "pi_plan"
+	Arg_ResourceGroupID = "pi_resource_group_id"
+
+	Attr_Capabilities = "capabilities"
+	Attr_CRN          = "crn"
+	Attr_SAPSupported = "sap_supported"
+	Attr_StorageTypes = "storage_types"
+
 	// VPN
 	PIVPNConnectionId                         = "connection_id"
 	PIVPNConnectionStatus                     = "connection_status"
@@ -86,4 +181,23 @@ const (
 	StatusBuild   = "BUILD"
 	SctionStart   = "start"
 	SctionStop    = "stop"
+
+	// volume group status states
+	VolumeGroupStatusAvailable = "available"
+	VolumeGroupStatusCreating  = "creating"
+	VolumeGroupStatusUpdating  = "updating"
+	VolumeGroupStatusDeleting  = "deleting"
+	VolumeGroupStatusError     = "error"
+
+	// shared processor pool status states
+	SharedProcessorPoolStatusActive      = "active"
+	SharedProcessorPoolStatusConfiguring = "configuring"
+	SharedProcessorPoolStatusFailed      = "failed"
+
+	// workspace (power-iaas service instance) creation
+	WorkspaceServiceName  = "power-iaas"
+	WorkspaceDefaultPlan  = "power-virtual-server-group"
+	WorkspaceStateActive  = "active"
+	WorkspaceStateFailed  = "failed"
+	WorkspaceStateRemoved = "removed"
 )
@@ -0,0 +1,207 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_s_p_p_placement_groups"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_shared_processor_pools"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+)
+
+/*
+Clients for the shared processor pool and SPP placement group endpoints of the
+power iaas api; the power-go-client in use does not ship these clients yet
+*/
+
+type piSharedProcessorPoolClient struct {
+	session         *ibmpisession.IBMPISession
+	cloudInstanceID string
+	ctx             context.Context
+}
+
+func newPISharedProcessorPoolClient(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID string) *piSharedProcessorPoolClient {
+	return &piSharedProcessorPoolClient{
+		session:         sess,
+		cloudInstanceID: cloudInstanceID,
+		ctx:             ctx,
+	}
+}
+
+// Get a shared processor pool with the instances it hosts
+func (f *piSharedProcessorPoolClient) Get(id string) (*models.SharedProcessorPoolDetail, error) {
+	params := p_cloud_shared_processor_pools.NewPcloudSharedprocessorpoolsGetParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithSharedProcessorPoolID(id)
+	resp, err := f.session.Power.PCloudSharedProcessorPools.PcloudSharedprocessorpoolsGet(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get shared processor pool %s: %w", id, err)
+	}
+	if resp == nil || resp.Payload == nil || resp.Payload.SharedProcessorPool == nil {
+		return nil, fmt.Errorf("failed to Get shared processor pool %s", id)
+	}
+	return resp.Payload, nil
+}
+
+// Get all shared processor pools
+func (f *piSharedProcessorPoolClient) GetAll() (*models.SharedProcessorPools, error) {
+	params := p_cloud_shared_processor_pools.NewPcloudSharedprocessorpoolsGetallParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID)
+	resp, err := f.session.Power.PCloudSharedProcessorPools.PcloudSharedprocessorpoolsGetall(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get all shared processor pools: %w", err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Get all shared processor pools")
+	}
+	return resp.Payload, nil
+}
+
+// Create a shared processor pool
+func (f *piSharedProcessorPoolClient) Create(body *models.SharedProcessorPoolCreate) (*models.SharedProcessorPool, error) {
+	params := p_cloud_shared_processor_pools.NewPcloudSharedprocessorpoolsPostParams().
+		WithContext(f.ctx).WithTimeout(helpers.PICreateTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithBody(body)
+	resp, err := f.session.Power.PCloudSharedProcessorPools.PcloudSharedprocessorpoolsPost(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Create shared processor pool: %w", err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Create shared processor pool")
+	}
+	return resp.Payload, nil
+}
+
+// Update the name or reserved cores of a shared processor pool
+func (f *piSharedProcessorPoolClient) Update(id string, body *models.SharedProcessorPoolUpdate) (*models.SharedProcessorPool, error) {
+	params := p_cloud_shared_processor_pools.NewPcloudSharedprocessorpoolsPutParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIUpdateTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithSharedProcessorPoolID(id).WithBody(body)
+	resp, err := f.session.Power.PCloudSharedProcessorPools.PcloudSharedprocessorpoolsPut(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Update shared processor pool %s: %w", id, err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Update shared processor pool %s", id)
+	}
+	return resp.Payload, nil
+}
+
+// Delete a shared processor pool
+func (f *piSharedProcessorPoolClient) Delete(id string) error {
+	params := p_cloud_shared_processor_pools.NewPcloudSharedprocessorpoolsDeleteParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIDeleteTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithSharedProcessorPoolID(id)
+	_, err := f.session.Power.PCloudSharedProcessorPools.PcloudSharedprocessorpoolsDelete(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return fmt.Errorf("failed to Delete shared processor pool %s: %w", id, err)
+	}
+	return nil
+}
+
+type piSPPPlacementGroupClient struct {
+	session         *ibmpisession.IBMPISession
+	cloudInstanceID string
+	ctx             context.Context
+}
+
+func newPISPPPlacementGroupClient(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID string) *piSPPPlacementGroupClient {
+	return &piSPPPlacementGroupClient{
+		session:         sess,
+		cloudInstanceID: cloudInstanceID,
+		ctx:             ctx,
+	}
+}
+
+// Get a SPP placement group
+func (f *piSPPPlacementGroupClient) Get(id string) (*models.SPPPlacementGroup, error) {
+	params := p_cloud_s_p_p_placement_groups.NewPcloudSppplacementgroupsGetParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithSppPlacementGroupID(id)
+	resp, err := f.session.Power.PCloudsppPlacementGroups.PcloudSppplacementgroupsGet(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get SPP placement group %s: %w", id, err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Get SPP placement group %s", id)
+	}
+	return resp.Payload, nil
+}
+
+// Get all SPP placement groups
+func (f *piSPPPlacementGroupClient) GetAll() (*models.SPPPlacementGroups, error) {
+	params := p_cloud_s_p_p_placement_groups.NewPcloudSppplacementgroupsGetallParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID)
+	resp, err := f.session.Power.PCloudsppPlacementGroups.PcloudSppplacementgroupsGetall(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get all SPP placement groups: %w", err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Get all SPP placement groups")
+	}
+	return resp.Payload, nil
+}
+
+// Create a SPP placement group
+func (f *piSPPPlacementGroupClient) Create(body *models.SPPPlacementGroupCreate) (*models.SPPPlacementGroup, error) {
+	params := p_cloud_s_p_p_placement_groups.NewPcloudSppplacementgroupsPostParams().
+		WithContext(f.ctx).WithTimeout(helpers.PICreateTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithBody(body)
+	resp, err := f.session.Power.PCloudsppPlacementGroups.PcloudSppplacementgroupsPost(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Create SPP placement group: %w", err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Create SPP placement group")
+	}
+	return resp.Payload, nil
+}
+
+// Delete a SPP placement group
+func (f *piSPPPlacementGroupClient) Delete(id string) error {
+	params := p_cloud_s_p_p_placement_groups.NewPcloudSppplacementgroupsDeleteParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIDeleteTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithSppPlacementGroupID(id)
+	_, err := f.session.Power.PCloudsppPlacementGroups.PcloudSppplacementgroupsDelete(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return fmt.Errorf("failed to Delete SPP placement group %s: %w", id, err)
+	}
+	return nil
+}
+
+// Add a shared processor pool to a SPP placement group
+func (f *piSPPPlacementGroupClient) AddMember(id string, sppID string) (*models.SPPPlacementGroup, error) {
+	params := p_cloud_s_p_p_placement_groups.NewPcloudSppplacementgroupsMembersPostParams().
+		WithContext(f.ctx).WithTimeout(helpers.PICreateTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithSppPlacementGroupID(id).WithSharedProcessorPoolID(sppID)
+	resp, err := f.session.Power.PCloudsppPlacementGroups.PcloudSppplacementgroupsMembersPost(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Add shared processor pool %s to SPP placement group %s: %w", sppID, id, err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Add shared processor pool %s to SPP placement group %s", sppID, id)
+	}
+	return resp.Payload, nil
+}
+
+// Remove a shared processor pool from a SPP placement group
+func (f *piSPPPlacementGroupClient) DeleteMember(id string, sppID string) (*models.SPPPlacementGroup, error) {
+	params := p_cloud_s_p_p_placement_groups.NewPcloudSppplacementgroupsMembersDeleteParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIDeleteTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithSppPlacementGroupID(id).WithSharedProcessorPoolID(sppID)
+	resp, err := f.session.Power.PCloudsppPlacementGroups.PcloudSppplacementgroupsMembersDelete(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Delete shared processor pool %s from SPP placement group %s: %w", sppID, id, err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Delete shared processor pool %s from SPP placement group %s", sppID, id)
+	}
+	return resp.Payload, nil
+}
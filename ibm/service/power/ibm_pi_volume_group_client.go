@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volume_groups"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+)
+
+/*
+Client for the volume group endpoints of the power iaas api; the power-go-client
+in use does not ship a volume group client yet
+*/
+
+type piVolumeGroupClient struct {
+	session         *ibmpisession.IBMPISession
+	cloudInstanceID string
+	ctx             context.Context
+}
+
+func newPIVolumeGroupClient(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID string) *piVolumeGroupClient {
+	return &piVolumeGroupClient{
+		session:         sess,
+		cloudInstanceID: cloudInstanceID,
+		ctx:             ctx,
+	}
+}
+
+// Get a volume group
+func (f *piVolumeGroupClient) Get(id string) (*models.VolumeGroup, error) {
+	params := p_cloud_volume_groups.NewPcloudVolumegroupsGetParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithVolumeGroupID(id)
+	resp, err := f.session.Power.PCloudVolumeGroups.PcloudVolumegroupsGet(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get volume group %s: %w", id, err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Get volume group %s", id)
+	}
+	return resp.Payload, nil
+}
+
+// Get a volume group with the member volume ids
+func (f *piVolumeGroupClient) GetDetails(id string) (*models.VolumeGroupDetails, error) {
+	params := p_cloud_volume_groups.NewPcloudVolumegroupsGetDetailsParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithVolumeGroupID(id)
+	resp, err := f.session.Power.PCloudVolumeGroups.PcloudVolumegroupsGetDetails(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get details of volume group %s: %w", id, err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Get details of volume group %s", id)
+	}
+	return resp.Payload, nil
+}
+
+// Get all volume groups
+func (f *piVolumeGroupClient) GetAll() (*models.VolumeGroups, error) {
+	params := p_cloud_volume_groups.NewPcloudVolumegroupsGetallParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID)
+	resp, err := f.session.Power.PCloudVolumeGroups.PcloudVolumegroupsGetall(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get all volume groups: %w", err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Get all volume groups")
+	}
+	return resp.Payload, nil
+}
+
+// Create a volume group
+func (f *piVolumeGroupClient) Create(body *models.VolumeGroupCreate) (*models.VolumeGroupCreateResponse, error) {
+	params := p_cloud_volume_groups.NewPcloudVolumegroupsPostParams().
+		WithContext(f.ctx).WithTimeout(helpers.PICreateTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithBody(body)
+	resp, err := f.session.Power.PCloudVolumeGroups.PcloudVolumegroupsPost(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Create volume group: %w", err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Create volume group")
+	}
+	return resp.Payload, nil
+}
+
+// Add or remove member volumes of a volume group
+func (f *piVolumeGroupClient) UpdateVolumeGroup(id string, body *models.VolumeGroupUpdate) error {
+	params := p_cloud_volume_groups.NewPcloudVolumegroupsPutParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIUpdateTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithVolumeGroupID(id).WithBody(body)
+	_, err := f.session.Power.PCloudVolumeGroups.PcloudVolumegroupsPut(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return fmt.Errorf("failed to Update volume group %s: %w", id, err)
+	}
+	return nil
+}
+
+// Delete a volume group
+func (f *piVolumeGroupClient) DeleteVolumeGroup(id string) error {
+	params := p_cloud_volume_groups.NewPcloudVolumegroupsDeleteParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIDeleteTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithVolumeGroupID(id)
+	_, err := f.session.Power.PCloudVolumeGroups.PcloudVolumegroupsDelete(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return fmt.Errorf("failed to Delete volume group %s: %w", id, err)
+	}
+	return nil
+}
+
+// Perform a start, stop or reset action on a volume group
+func (f *piVolumeGroupClient) VolumeGroupAction(id string, body *models.VolumeGroupAction) error {
+	params := p_cloud_volume_groups.NewPcloudVolumegroupsActionPostParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIUpdateTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithVolumeGroupID(id).WithBody(body)
+	_, err := f.session.Power.PCloudVolumeGroups.PcloudVolumegroupsActionPost(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return fmt.Errorf("failed to perform action on volume group %s: %w", id, err)
+	}
+	return nil
+}
+
+// Get the remote copy relationships of the volumes in a volume group
+func (f *piVolumeGroupClient) GetVolumeGroupRemoteCopyRelationships(id string) (*models.VolumeGroupRemoteCopyRelationships, error) {
+	params := p_cloud_volume_groups.NewPcloudVolumegroupsRemoteCopyRelationshipsGetParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithVolumeGroupID(id)
+	resp, err := f.session.Power.PCloudVolumeGroups.PcloudVolumegroupsRemoteCopyRelationshipsGet(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get remote copy relationships of volume group %s: %w", id, err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Get remote copy relationships of volume group %s", id)
+	}
+	return resp.Payload, nil
+}
+
+// Get the storage controller details of a volume group
+func (f *piVolumeGroupClient) GetVolumeGroupStorageDetails(id string) (*models.VolumeGroupStorageDetails, error) {
+	params := p_cloud_volume_groups.NewPcloudVolumegroupsStorageDetailsGetParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithVolumeGroupID(id)
+	resp, err := f.session.Power.PCloudVolumeGroups.PcloudVolumegroupsStorageDetailsGet(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get storage details of volume group %s: %w", id, err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Get storage details of volume group %s", id)
+	}
+	return resp.Payload, nil
+}
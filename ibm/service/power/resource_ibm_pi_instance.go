@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -31,6 +32,11 @@ func ResourceIBMPIInstance() *schema.Resource {
 		DeleteContext: resourceIBMPIInstanceDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.Sequence(
+			resourceIBMPIInstanceResizeDiff,
+			workspaceCapabilitiesCustomizeDiff(helpers.PIInstanceStorageType, PISAPInstanceProfileID),
+		),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -82,6 +88,11 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Computed:    true,
 				Description: "Maximum memory size",
 			},
+			Attr_InstanceResizeMode: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "How the last planned memory or processor change is applied: dlpar for a live change, shutdown when the lpar has to be stopped",
+			},
 			helpers.PIInstanceVolumeIds: {
 				Type:             schema.TypeSet,
 				Optional:         true,
@@ -193,6 +204,18 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Optional:    true,
 				Description: "Placement group ID",
 			},
+			Arg_SharedProcessorPool: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{PISAPInstanceProfileID},
+				Description:   "Name of the shared processor pool the instance is deployed in",
+			},
+			Attr_SharedProcessorPoolID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Shared processor pool ID the instance is deployed in",
+			},
 			"health_status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -435,6 +458,10 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	if *powervmdata.PlacementGroup != "none" {
 		d.Set(helpers.PIPlacementGroupID, powervmdata.PlacementGroup)
 	}
+	if powervmdata.SharedProcessorPool != "" {
+		d.Set(Arg_SharedProcessorPool, powervmdata.SharedProcessorPool)
+	}
+	d.Set(Attr_SharedProcessorPoolID, powervmdata.SharedProcessorPoolID)
 
 	networksMap := []map[string]interface{}{}
 	if powervmdata.Networks != nil {
@@ -581,20 +608,20 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 	// Start of the change for Memory and Processors
 	if d.HasChange(helpers.PIInstanceMemory) || d.HasChange(helpers.PIInstanceProcessors) || d.HasChange("pi_migratable") {
 
+		minMemLpar := d.Get("min_memory").(float64)
 		maxMemLpar := d.Get("max_memory").(float64)
+		minCPULpar := d.Get("min_processors").(float64)
 		maxCPULpar := d.Get("max_processors").(float64)
-		//log.Printf("the required memory is set to [%d] and current max memory is set to  [%d] ", int(mem), int(maxMemLpar))
 
-		if mem > maxMemLpar || procs > maxCPULpar {
+		requiresShutdown := resizeRequiresShutdown(mem, procs, minMemLpar, maxMemLpar, minCPULpar, maxCPULpar)
+		if requiresShutdown {
 			log.Printf("Will require a shutdown to perform the change")
 		} else {
 			log.Printf("maxMemLpar is set to %f", maxMemLpar)
 			log.Printf("maxCPULpar is set to %f", maxCPULpar)
 		}
 
-		//if d.GetOkExists("reboot_for_resource_change")
-
-		if mem > maxMemLpar || procs > maxCPULpar {
+		if requiresShutdown {
 
 			err = performChangeAndReboot(ctx, client, instanceID, cloudInstanceID, mem, procs)
 			if err != nil {
@@ -942,6 +969,113 @@ func performChangeAndReboot(ctx context.Context, client *st.IBMPIInstanceClient,
 
 }
 
+// resizeRequiresShutdown reports whether a memory / processor change falls outside
+// the lpar min/max profile and therefore cannot be applied as a live DLPAR operation
+func resizeRequiresShutdown(mem, procs, minMem, maxMem, minProcs, maxProcs float64) bool {
+	return mem > maxMem || procs > maxProcs || mem < minMem || procs < minProcs
+}
+
+// systemPoolFits reports whether at least one host of the system pool has room for
+// the requested memory (GB) and processors
+func systemPoolFits(pool models.SystemPool, mem, procs float64) bool {
+	if len(pool.Systems) == 0 {
+		if pool.MaxAvailable == nil || pool.MaxAvailable.Cores == nil || pool.MaxAvailable.Memory == nil {
+			return true
+		}
+		return *pool.MaxAvailable.Cores >= procs && float64(*pool.MaxAvailable.Memory) >= mem
+	}
+	for _, s := range pool.Systems {
+		if s == nil || s.Cores == nil || s.Memory == nil {
+			continue
+		}
+		if *s.Cores >= procs && float64(*s.Memory) >= mem {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceIBMPIInstanceResizeDiff reports in the plan whether a memory / processor change
+// is applied live or requires the lpar to be stopped, and rejects changes the system pool
+// of the instance cannot accommodate
+func resourceIBMPIInstanceResizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := diff.GetOk(PISAPInstanceProfileID); ok && diff.Id() == "" {
+		return nil
+	}
+	if !diff.NewValueKnown(helpers.PIInstanceMemory) || !diff.NewValueKnown(helpers.PIInstanceProcessors) {
+		return nil
+	}
+
+	oldMemRaw, newMemRaw := diff.GetChange(helpers.PIInstanceMemory)
+	oldProcsRaw, newProcsRaw := diff.GetChange(helpers.PIInstanceProcessors)
+	oldMem, mem := oldMemRaw.(float64), newMemRaw.(float64)
+	oldProcs, procs := oldProcsRaw.(float64), newProcsRaw.(float64)
+
+	// memory and processors the pool has to provide on top of what the lpar already holds
+	reqMem, reqProcs := mem, procs
+	if diff.Id() != "" {
+		if !diff.HasChange(helpers.PIInstanceMemory) && !diff.HasChange(helpers.PIInstanceProcessors) {
+			if diff.HasChange(helpers.PIInstanceProcType) || diff.HasChange(PISAPInstanceProfileID) {
+				return diff.SetNew(Attr_InstanceResizeMode, ResizeModeShutdown)
+			}
+			// any other update does not resize the lpar, so the mode of an earlier resize is cleared
+			if diff.Get(Attr_InstanceResizeMode).(string) != "" && len(diff.GetChangedKeysPrefix("")) > 0 {
+				return diff.SetNew(Attr_InstanceResizeMode, "")
+			}
+			return nil
+		}
+
+		mode := ResizeModeDLPAR
+		if resizeRequiresShutdown(mem, procs,
+			diff.Get("min_memory").(float64), diff.Get("max_memory").(float64),
+			diff.Get("min_processors").(float64), diff.Get("max_processors").(float64)) ||
+			diff.HasChange(helpers.PIInstanceProcType) || diff.HasChange(PISAPInstanceProfileID) {
+			mode = ResizeModeShutdown
+		}
+		log.Printf("[INFO] memory / processor change on pvm instance %s will be applied with mode %s", diff.Id(), mode)
+		if err := diff.SetNew(Attr_InstanceResizeMode, mode); err != nil {
+			return err
+		}
+
+		reqMem, reqProcs = mem-oldMem, procs-oldProcs
+		if reqMem < 0 {
+			reqMem = 0
+		}
+		if reqProcs < 0 {
+			reqProcs = 0
+		}
+	}
+	if reqMem == 0 && reqProcs == 0 {
+		return nil
+	}
+
+	cloudInstanceID := diff.Get(helpers.PICloudInstanceId).(string)
+	sysType := diff.Get(helpers.PIInstanceSystemType).(string)
+	if cloudInstanceID == "" || sysType == "" {
+		return nil
+	}
+
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	client := st.NewIBMPISystemPoolClient(ctx, sess, cloudInstanceID)
+	pools, err := client.GetSystemPools()
+	if err != nil {
+		return fmt.Errorf("[ERROR] failed to get the system pools to validate the %s and %s: %v", helpers.PIInstanceMemory, helpers.PIInstanceProcessors, err)
+	}
+	pool, ok := pools[sysType]
+	if !ok {
+		log.Printf("[WARN] system pool %s not found, skipping the capacity validation", sysType)
+		return nil
+	}
+	if !systemPoolFits(pool, reqMem, reqProcs) {
+		return fmt.Errorf("[ERROR] the %s system pool has no host with %.2f processors and %.0f GB of memory available for %s = %.2f and %s = %.0f",
+			sysType, reqProcs, reqMem, helpers.PIInstanceProcessors, procs, helpers.PIInstanceMemory, mem)
+	}
+	return nil
+}
+
 func isWaitforPIInstanceUpdate(ctx context.Context, client *st.IBMPIInstanceClient, id string) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be SHUTOFF AFTER THE RESIZE Due to DLPAR Operation ", id)
 
@@ -1258,6 +1392,10 @@ func createPVMInstance(d *schema.ResourceData, client *st.IBMPIInstanceClient, i
 		body.PlacementGroup = pg.(string)
 	}
 
+	if spp, ok := d.GetOk(Arg_SharedProcessorPool); ok {
+		body.SharedProcessorPool = spp.(string)
+	}
+
 	if lrc, ok := d.GetOk(helpers.PIInstanceLicenseRepositoryCapacity); ok {
 		// check if using vtl image
 		// check if vtl image is stock image
@@ -0,0 +1,422 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/power-go-client/errors"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_shared_processor_pools"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func ResourceIBMPISharedProcessorPool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPISharedProcessorPoolCreate,
+		ReadContext:   resourceIBMPISharedProcessorPoolRead,
+		UpdateContext: resourceIBMPISharedProcessorPoolUpdate,
+		DeleteContext: resourceIBMPISharedProcessorPoolDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "PI cloud instance ID",
+			},
+			Arg_SharedProcessorPoolName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Name of the shared processor pool",
+			},
+			Arg_SharedProcessorPoolHostGroup: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Host group of the shared processor pool, for example s922 or e980",
+			},
+			Arg_SharedProcessorPoolReservedCores: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The amount of reserved cores for the shared processor pool",
+			},
+
+			// Optional Arguments
+			Arg_SharedProcessorPoolPlacementGroupID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "SPP placement group the shared processor pool is a member of",
+			},
+
+			// Attributes
+			Attr_SharedProcessorPoolID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Shared processor pool ID",
+			},
+			Attr_AllocatedCores: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Shared processor pool allocated cores",
+			},
+			Attr_AvailableCores: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Shared processor pool available cores",
+			},
+			Attr_HostID: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The host ID where the shared processor pool resides",
+			},
+			Attr_Status: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the shared processor pool",
+			},
+			Attr_StatusDetail: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status details of the shared processor pool",
+			},
+			Attr_Instances: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of server instances deployed in the shared processor pool",
+				Elem:        sharedProcessorPoolInstanceSchema(),
+			},
+		},
+	}
+}
+
+func resourceIBMPISharedProcessorPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	name := d.Get(Arg_SharedProcessorPoolName).(string)
+	hostGroup := d.Get(Arg_SharedProcessorPoolHostGroup).(string)
+	reservedCores := int64(d.Get(Arg_SharedProcessorPoolReservedCores).(int))
+
+	body := &models.SharedProcessorPoolCreate{
+		Name:          &name,
+		HostGroup:     &hostGroup,
+		ReservedCores: &reservedCores,
+	}
+	if pg, ok := d.GetOk(Arg_SharedProcessorPoolPlacementGroupID); ok {
+		body.PlacementGroupID = pg.(string)
+	}
+
+	client := newPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+	spp, err := client.Create(body)
+	if err != nil {
+		log.Printf("[DEBUG] create shared processor pool failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *spp.ID))
+
+	_, err = isWaitForIBMPISharedProcessorPoolAvailable(ctx, client, *spp.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPISharedProcessorPoolRead(ctx, d, meta)
+}
+
+func resourceIBMPISharedProcessorPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, sppID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := newPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+	detail, err := client.Get(sppID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_shared_processor_pools.PcloudSharedprocessorpoolsGetNotFound:
+			log.Printf("[DEBUG] shared processor pool does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] get shared processor pool failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	spp := detail.SharedProcessorPool
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Attr_SharedProcessorPoolID, spp.ID)
+	d.Set(Arg_SharedProcessorPoolName, spp.Name)
+	d.Set(Arg_SharedProcessorPoolHostGroup, spp.HostGroup)
+	d.Set(Arg_SharedProcessorPoolReservedCores, spp.ReservedCores)
+	d.Set(Attr_AllocatedCores, spp.AllocatedCores)
+	d.Set(Attr_AvailableCores, spp.AvailableCores)
+	d.Set(Attr_HostID, spp.HostID)
+	d.Set(Attr_Status, spp.Status)
+	d.Set(Attr_StatusDetail, spp.StatusDetail)
+	d.Set(Attr_Instances, flattenSharedProcessorPoolInstances(detail.Servers))
+
+	pgs, err := newPISPPPlacementGroupClient(ctx, sess, cloudInstanceID).GetAll()
+	if err != nil {
+		log.Printf("[DEBUG] get all SPP placement groups failed %v", err)
+		return diag.FromErr(err)
+	}
+	d.Set(Arg_SharedProcessorPoolPlacementGroupID, sharedProcessorPoolPlacementGroupID(pgs, spp))
+
+	return nil
+}
+
+// sharedProcessorPoolPlacementGroupID returns the ID of the SPP placement group the shared processor
+// pool is a member of, or an empty string
+func sharedProcessorPoolPlacementGroupID(pgs *models.SPPPlacementGroups, spp *models.SharedProcessorPool) string {
+	for _, pg := range pgs.SppPlacementGroups {
+		if pg == nil || pg.ID == nil {
+			continue
+		}
+		for _, member := range pg.MemberSharedProcessorPools {
+			if (spp.ID != nil && member == *spp.ID) || (spp.Name != nil && member == *spp.Name) {
+				return *pg.ID
+			}
+		}
+	}
+	return ""
+}
+
+func resourceIBMPISharedProcessorPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, sppID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := newPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+
+	if d.HasChanges(Arg_SharedProcessorPoolName, Arg_SharedProcessorPoolReservedCores) {
+		body := &models.SharedProcessorPoolUpdate{}
+		if d.HasChange(Arg_SharedProcessorPoolName) {
+			body.Name = d.Get(Arg_SharedProcessorPoolName).(string)
+		}
+		if d.HasChange(Arg_SharedProcessorPoolReservedCores) {
+			body.ReservedCores = int64(d.Get(Arg_SharedProcessorPoolReservedCores).(int))
+		}
+		_, err = client.Update(sppID, body)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = isWaitForIBMPISharedProcessorPoolAvailable(ctx, client, sppID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(Arg_SharedProcessorPoolPlacementGroupID) {
+		pgClient := newPISPPPlacementGroupClient(ctx, sess, cloudInstanceID)
+		oldRaw, newRaw := d.GetChange(Arg_SharedProcessorPoolPlacementGroupID)
+		oldPG, newPG := oldRaw.(string), newRaw.(string)
+
+		if oldPG != "" {
+			_, err = pgClient.DeleteMember(oldPG, sppID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if newPG != "" {
+			_, err = pgClient.AddMember(newPG, sppID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceIBMPISharedProcessorPoolRead(ctx, d, meta)
+}
+
+func resourceIBMPISharedProcessorPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, sppID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := newPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+	err = client.Delete(sppID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_shared_processor_pools.PcloudSharedprocessorpoolsDeleteNotFound:
+			log.Printf("[DEBUG] shared processor pool does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] delete shared processor pool failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	_, err = isWaitForIBMPISharedProcessorPoolDeleted(ctx, client, sppID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForIBMPISharedProcessorPoolAvailable(ctx context.Context, client *piSharedProcessorPoolClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for shared processor pool (%s) to be available.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{SharedProcessorPoolStatusConfiguring},
+		Target:  []string{SharedProcessorPoolStatusActive},
+		Refresh: func() (interface{}, string, error) {
+			detail, err := client.Get(id)
+			if err != nil {
+				return nil, "", err
+			}
+			spp := detail.SharedProcessorPool
+			switch spp.Status {
+			case SharedProcessorPoolStatusFailed:
+				return spp, spp.Status, fmt.Errorf("[ERROR] shared processor pool %s failed: %s", id, spp.StatusDetail)
+			case "":
+				// the status is not reported yet right after the create request
+				return spp, SharedProcessorPoolStatusConfiguring, nil
+			}
+			return spp, spp.Status, nil
+		},
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isWaitForIBMPISharedProcessorPoolDeleted(ctx context.Context, client *piSharedProcessorPoolClient, id string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{SharedProcessorPoolStatusActive, SharedProcessorPoolStatusConfiguring},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			detail, err := client.Get(id)
+			if err != nil {
+				uErr := errors.Unwrap(err)
+				switch uErr.(type) {
+				case *p_cloud_shared_processor_pools.PcloudSharedprocessorpoolsGetNotFound:
+					log.Printf("[DEBUG] shared processor pool does not exist %v", err)
+					return id, "deleted", nil
+				}
+				return nil, "", err
+			}
+			return detail, detail.SharedProcessorPool.Status, nil
+		},
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func sharedProcessorPoolInstanceSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			Attr_AvailabilityZone: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The availability zone of the instance",
+			},
+			Attr_Cpus: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The amount of cpus of the instance",
+			},
+			Attr_ID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The instance ID",
+			},
+			Attr_Memory: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The amount of memory of the instance",
+			},
+			Attr_Name: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The instance name",
+			},
+			Attr_Status: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the instance",
+			},
+			Attr_Uncapped: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Identifies if the instance is uncapped",
+			},
+			Attr_Vcpus: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The amount of virtual cpus of the instance",
+			},
+		},
+	}
+}
+
+func flattenSharedProcessorPoolInstances(servers []*models.SharedProcessorPoolServer) []map[string]interface{} {
+	instances := make([]map[string]interface{}, 0, len(servers))
+	for _, s := range servers {
+		if s == nil {
+			continue
+		}
+		instances = append(instances, map[string]interface{}{
+			Attr_AvailabilityZone: s.AvailabilityZone,
+			Attr_Cpus:             s.Cpus,
+			Attr_ID:               s.ID,
+			Attr_Memory:           s.Memory,
+			Attr_Name:             s.Name,
+			Attr_Status:           s.Status,
+			Attr_Uncapped:         s.Uncapped,
+			Attr_Vcpus:            s.Vcpus,
+		})
+	}
+	return instances
+}
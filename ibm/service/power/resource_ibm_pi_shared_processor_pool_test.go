@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_shared_processor_pools"
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func TestAccIBMPISharedProcessorPoolbasic(t *testing.T) {
+	name := fmt.Sprintf("tf_pi_spp_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPISharedProcessorPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPISharedProcessorPoolConfig(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPISharedProcessorPoolExists("ibm_pi_shared_processor_pool.power_shared_pool"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_shared_processor_pool.power_shared_pool", "pi_shared_processor_pool_name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_shared_processor_pool.power_shared_pool", "pi_shared_processor_pool_reserved_cores", "1"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_pi_shared_processor_pool.power_shared_pool", "pi_shared_processor_pool_placement_group_id",
+						"ibm_pi_spp_placement_group.power_spp_placement_group", "spp_placement_group_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPISharedProcessorPoolConfig(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPISharedProcessorPoolExists("ibm_pi_shared_processor_pool.power_shared_pool"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_shared_processor_pool.power_shared_pool", "pi_shared_processor_pool_reserved_cores", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPISharedProcessorPoolDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_shared_processor_pool" {
+			continue
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		params := p_cloud_shared_processor_pools.NewPcloudSharedprocessorpoolsGetParams().
+			WithContext(context.Background()).
+			WithCloudInstanceID(parts[0]).WithSharedProcessorPoolID(parts[1])
+		_, err = sess.Power.PCloudSharedProcessorPools.PcloudSharedprocessorpoolsGet(params, sess.AuthInfo(parts[0]))
+		if err == nil {
+			return fmt.Errorf("PI shared processor pool still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMPISharedProcessorPoolExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		params := p_cloud_shared_processor_pools.NewPcloudSharedprocessorpoolsGetParams().
+			WithContext(context.Background()).
+			WithCloudInstanceID(parts[0]).WithSharedProcessorPoolID(parts[1])
+		_, err = sess.Power.PCloudSharedProcessorPools.PcloudSharedprocessorpoolsGet(params, sess.AuthInfo(parts[0]))
+		return err
+	}
+}
+
+func testAccCheckIBMPISharedProcessorPoolConfig(name string, reservedCores int) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_spp_placement_group" "power_spp_placement_group" {
+		pi_cloud_instance_id          = "%[1]s"
+		pi_spp_placement_group_name   = "%[2]s_pg"
+		pi_spp_placement_group_policy = "affinity"
+	}
+	resource "ibm_pi_shared_processor_pool" "power_shared_pool" {
+		pi_cloud_instance_id                        = "%[1]s"
+		pi_shared_processor_pool_name               = "%[2]s"
+		pi_shared_processor_pool_host_group         = "s922"
+		pi_shared_processor_pool_reserved_cores     = %[3]d
+		pi_shared_processor_pool_placement_group_id = ibm_pi_spp_placement_group.power_spp_placement_group.spp_placement_group_id
+	}
+	`, acc.Pi_cloud_instance_id, name, reservedCores)
+}
@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/power-go-client/errors"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_s_p_p_placement_groups"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func ResourceIBMPISPPPlacementGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPISPPPlacementGroupCreate,
+		ReadContext:   resourceIBMPISPPPlacementGroupRead,
+		DeleteContext: resourceIBMPISPPPlacementGroupDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "PI cloud instance ID",
+			},
+			Arg_SPPPlacementGroupName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Name of the SPP placement group",
+			},
+			Arg_SPPPlacementGroupPolicy: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"affinity", "anti-affinity"}),
+				Description:  "Policy of the SPP placement group",
+			},
+
+			// Attributes
+			Attr_SPPPlacementGroupID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SPP placement group ID",
+			},
+			Attr_Members: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Shared processor pool IDs that are the SPP placement group members",
+			},
+		},
+	}
+}
+
+func resourceIBMPISPPPlacementGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	name := d.Get(Arg_SPPPlacementGroupName).(string)
+	policy := d.Get(Arg_SPPPlacementGroupPolicy).(string)
+
+	client := newPISPPPlacementGroupClient(ctx, sess, cloudInstanceID)
+	pg, err := client.Create(&models.SPPPlacementGroupCreate{
+		Name:   &name,
+		Policy: &policy,
+	})
+	if err != nil {
+		log.Printf("[DEBUG] create SPP placement group failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *pg.ID))
+
+	return resourceIBMPISPPPlacementGroupRead(ctx, d, meta)
+}
+
+func resourceIBMPISPPPlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, pgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := newPISPPPlacementGroupClient(ctx, sess, cloudInstanceID)
+	pg, err := client.Get(pgID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_s_p_p_placement_groups.PcloudSppplacementgroupsGetNotFound:
+			log.Printf("[DEBUG] SPP placement group does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] get SPP placement group failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Attr_SPPPlacementGroupID, pg.ID)
+	d.Set(Arg_SPPPlacementGroupName, pg.Name)
+	d.Set(Arg_SPPPlacementGroupPolicy, pg.Policy)
+	d.Set(Attr_Members, pg.MemberSharedProcessorPools)
+
+	return nil
+}
+
+func resourceIBMPISPPPlacementGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, pgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := newPISPPPlacementGroupClient(ctx, sess, cloudInstanceID)
+	err = client.Delete(pgID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_s_p_p_placement_groups.PcloudSppplacementgroupsDeleteNotFound:
+			log.Printf("[DEBUG] SPP placement group does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] delete SPP placement group failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -29,6 +29,7 @@ func ResourceIBMPIVolume() *schema.Resource {
 		UpdateContext: resourceIBMPIVolumeUpdate,
 		DeleteContext: resourceIBMPIVolumeDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: workspaceCapabilitiesCustomizeDiff(helpers.PIVolumeType, ""),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -109,6 +110,13 @@ func ResourceIBMPIVolume() *schema.Resource {
 				Description:      "List of pvmInstances to base volume anti-affinity policy against; required if requesting anti-affinity and pi_anti_affinity_volumes is not provided",
 				ConflictsWith:    []string{PIAntiAffinityVolumes},
 			},
+			Arg_ReplicationEnabled: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Indicates if the volume should be replication enabled or not",
+			},
 
 			// Computed Attributes
 			"volume_id": {
@@ -132,6 +140,41 @@ func ResourceIBMPIVolume() *schema.Resource {
 				Computed:    true,
 				Description: "WWN Of the volume",
 			},
+			Attr_ReplicationType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Replication type(metro,global)",
+			},
+			Attr_ReplicationStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Replication status of a volume",
+			},
+			Attr_MirroringState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Mirroring state for replication enabled volume",
+			},
+			Attr_GroupID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Volume Group ID",
+			},
+			Attr_ConsistencyGroupName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Consistency Group Name if volume is a part of volume group",
+			},
+			Attr_MasterVolumeName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Master volume name at storage host level",
+			},
+			Attr_AuxVolumeName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Auxiliary volume name at storage host level",
+			},
 		},
 	}
 }
@@ -178,6 +221,10 @@ func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta
 		volumePool := v.(string)
 		body.VolumePool = volumePool
 	}
+	if v, ok := d.GetOk(Arg_ReplicationEnabled); ok {
+		replicationEnabled := v.(bool)
+		body.ReplicationEnabled = &replicationEnabled
+	}
 	if ap, ok := d.GetOk(PIAffinityPolicy); ok {
 		policy := ap.(string)
 		body.AffinityPolicy = &policy
@@ -253,6 +300,14 @@ func resourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta i
 		d.Set("delete_on_termination", vol.DeleteOnTermination)
 	}
 	d.Set("wwn", vol.Wwn)
+	d.Set(Arg_ReplicationEnabled, vol.ReplicationEnabled)
+	d.Set(Attr_ReplicationType, vol.ReplicationType)
+	d.Set(Attr_ReplicationStatus, vol.ReplicationStatus)
+	d.Set(Attr_MirroringState, vol.MirroringState)
+	d.Set(Attr_GroupID, vol.GroupID)
+	d.Set(Attr_ConsistencyGroupName, vol.ConsistencyGroupName)
+	d.Set(Attr_MasterVolumeName, vol.MasterVolumeName)
+	d.Set(Attr_AuxVolumeName, vol.AuxVolumeName)
 	d.Set(helpers.PICloudInstanceId, cloudInstanceID)
 
 	return nil
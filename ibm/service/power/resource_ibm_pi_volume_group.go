@@ -0,0 +1,374 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/power-go-client/errors"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volume_groups"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMPIVolumeGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIVolumeGroupCreate,
+		ReadContext:   resourceIBMPIVolumeGroupRead,
+		UpdateContext: resourceIBMPIVolumeGroupUpdate,
+		DeleteContext: resourceIBMPIVolumeGroupDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "PI cloud instance ID",
+			},
+			Arg_VolumeIDs: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "List of volume IDs to add to the volume group; all volumes must be replication enabled",
+			},
+
+			// Optional Arguments
+			Arg_VolumeGroupName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{Arg_VolumeGroupName, Arg_ConsistencyGroupName},
+				Description:  "Name of the volume group to create",
+			},
+			Arg_ConsistencyGroupName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{Arg_VolumeGroupName, Arg_ConsistencyGroupName},
+				Description:  "Name of an existing consistency group at storage controller level, used to onboard the volume group on the target site for DR set up",
+			},
+
+			// Attributes
+			Attr_VolumeGroupID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the volume group",
+			},
+			Attr_VolumeGroupStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the volume group",
+			},
+			Attr_ReplicationStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The replication status of the volume group",
+			},
+			Attr_ConsistencyGroupName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the consistency group at storage controller level",
+			},
+			Attr_StatusDescriptionErrors: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The status details of the volume group",
+				Elem:        volumeGroupStatusDescriptionErrorSchema(),
+			},
+		},
+	}
+}
+
+func resourceIBMPIVolumeGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	body := &models.VolumeGroupCreate{
+		VolumeIDs: flex.ExpandStringList(d.Get(Arg_VolumeIDs).(*schema.Set).List()),
+	}
+	if v, ok := d.GetOk(Arg_VolumeGroupName); ok {
+		body.Name = v.(string)
+	}
+	if v, ok := d.GetOk(Arg_ConsistencyGroupName); ok {
+		body.ConsistencyGroupName = v.(string)
+	}
+
+	client := newPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	vg, err := client.Create(body)
+	if err != nil {
+		log.Printf("[DEBUG] create volume group failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *vg.ID))
+
+	_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, *vg.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIVolumeGroupRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, vgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := newPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	vg, err := client.GetDetails(vgID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_volume_groups.PcloudVolumegroupsGetDetailsNotFound:
+			log.Printf("[DEBUG] volume group does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] get volume group failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Attr_VolumeGroupID, vg.ID)
+	if _, ok := d.GetOk(Arg_ConsistencyGroupName); !ok {
+		d.Set(Arg_VolumeGroupName, vg.Name)
+	}
+	d.Set(Arg_VolumeIDs, vg.VolumeIDs)
+	d.Set(Attr_VolumeGroupStatus, vg.Status)
+	d.Set(Attr_ReplicationStatus, vg.ReplicationStatus)
+	d.Set(Attr_ConsistencyGroupName, vg.ConsistencyGroupName)
+	d.Set(Attr_StatusDescriptionErrors, flattenVolumeGroupStatusDescriptionErrors(vg.StatusDescription))
+
+	return nil
+}
+
+func resourceIBMPIVolumeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, vgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := newPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	if d.HasChange(Arg_VolumeIDs) {
+		oldRaw, newRaw := d.GetChange(Arg_VolumeIDs)
+		old := oldRaw.(*schema.Set)
+		new := newRaw.(*schema.Set)
+
+		body := &models.VolumeGroupUpdate{
+			AddVolumes:    flex.ExpandStringList(new.Difference(old).List()),
+			RemoveVolumes: flex.ExpandStringList(old.Difference(new).List()),
+		}
+		err = client.UpdateVolumeGroup(vgID, body)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMPIVolumeGroupRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, vgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := newPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+
+	// a volume group can only be deleted once all its volumes are removed
+	volumeIDs := flex.ExpandStringList(d.Get(Arg_VolumeIDs).(*schema.Set).List())
+	if len(volumeIDs) > 0 {
+		body := &models.VolumeGroupUpdate{
+			AddVolumes:    []string{},
+			RemoveVolumes: volumeIDs,
+		}
+		err = client.UpdateVolumeGroup(vgID, body)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = client.DeleteVolumeGroup(vgID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_volume_groups.PcloudVolumegroupsDeleteNotFound:
+			log.Printf("[DEBUG] volume group does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_, err = isWaitForIBMPIVolumeGroupDeleted(ctx, client, vgID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForIBMPIVolumeGroupAvailable(ctx context.Context, client *piVolumeGroupClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Volume Group (%s) to be available.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", VolumeGroupStatusCreating, VolumeGroupStatusUpdating},
+		Target:     []string{VolumeGroupStatusAvailable},
+		Refresh:    isIBMPIVolumeGroupRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeGroupRefreshFunc(client *piVolumeGroupClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vg, err := client.Get(id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		switch vg.Status {
+		case VolumeGroupStatusAvailable:
+			return vg, VolumeGroupStatusAvailable, nil
+		case VolumeGroupStatusError:
+			return vg, vg.Status, fmt.Errorf("[ERROR] volume group %s is in error state: %s", id, volumeGroupStatusErrorMessage(vg.StatusDescription))
+		case "":
+			return vg, "retry", nil
+		}
+		return vg, vg.Status, nil
+	}
+}
+
+func isWaitForIBMPIVolumeGroupDeleted(ctx context.Context, client *piVolumeGroupClient, id string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{VolumeGroupStatusDeleting},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			vg, err := client.Get(id)
+			if err != nil {
+				uErr := errors.Unwrap(err)
+				switch uErr.(type) {
+				case *p_cloud_volume_groups.PcloudVolumegroupsGetNotFound:
+					log.Printf("[DEBUG] volume group does not exist %v", err)
+					return &models.VolumeGroup{}, "deleted", nil
+				}
+				return nil, "", err
+			}
+			return vg, VolumeGroupStatusDeleting, nil
+		},
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func volumeGroupStatusDescriptionErrorSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			Attr_ErrorKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The volume group error key",
+			},
+			Attr_Message: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The failure message providing more details about the error key",
+			},
+			Attr_VolumeIDs: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of volume IDs which failed to be added to or removed from the volume group with the given error",
+			},
+		},
+	}
+}
+
+func flattenVolumeGroupStatusDescriptionErrors(sd *models.StatusDescription) []map[string]interface{} {
+	if sd == nil {
+		return nil
+	}
+	result := make([]map[string]interface{}, 0, len(sd.Errors))
+	for _, e := range sd.Errors {
+		if e == nil {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			Attr_ErrorKey:  e.Key,
+			Attr_Message:   e.Message,
+			Attr_VolumeIDs: e.VolIDs,
+		})
+	}
+	return result
+}
+
+func volumeGroupStatusErrorMessage(sd *models.StatusDescription) string {
+	if sd == nil || len(sd.Errors) == 0 {
+		return "no details provided"
+	}
+	msg := ""
+	for i, e := range sd.Errors {
+		if e == nil {
+			continue
+		}
+		if i > 0 {
+			msg += "; "
+		}
+		msg += fmt.Sprintf("%s: %s %v", e.Key, e.Message, e.VolIDs)
+	}
+	return msg
+}
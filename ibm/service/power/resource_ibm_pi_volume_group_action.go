@@ -0,0 +1,243 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volume_groups"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+/*
+Resource to perform a replication action on a volume group
+
+	start with source master : start or fail back the replication from the primary site
+	start with source aux    : fail back after a fail over, replicating from the secondary site
+	stop with access true    : fail over, giving read/write access to the auxiliary volumes
+	stop with access false   : stop the replication
+	reset with status available : reset the volume group status after an error
+*/
+
+func ResourceIBMPIVolumeGroupAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIVolumeGroupActionCreate,
+		ReadContext:   resourceIBMPIVolumeGroupActionRead,
+		DeleteContext: resourceIBMPIVolumeGroupActionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "PI cloud instance ID",
+			},
+			Arg_VolumeGroupID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Volume group ID",
+			},
+			Arg_VolumeGroupAction: {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				MinItems:    1,
+				Description: "Performs an action (start / stop / reset) on a volume group (one at a time)",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Start: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Description: "Performs start action on a volume group",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_Source: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{"master", "aux"}),
+										Description:  "Indicates the source of the action `master` or `aux`",
+									},
+								},
+							},
+						},
+						Attr_Stop: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Description: "Performs stop action on a volume group",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_Access: {
+										Type:        schema.TypeBool,
+										Required:    true,
+										ForceNew:    true,
+										Description: "Indicates the access mode of aux volumes; true gives read/write access to the aux volumes (fail over)",
+									},
+								},
+							},
+						},
+						Attr_Reset: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Description: "Performs reset action on the volume group to update its status value",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_Status: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{VolumeGroupStatusAvailable}),
+										Description:  "New status to be set for a volume group",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			// Attributes
+			Attr_VolumeGroupStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the volume group",
+			},
+			Attr_ReplicationStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The replication status of the volume group",
+			},
+			Attr_StatusDescriptionErrors: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The status details of the volume group",
+				Elem:        volumeGroupStatusDescriptionErrorSchema(),
+			},
+		},
+	}
+}
+
+func resourceIBMPIVolumeGroupActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	vgID := d.Get(Arg_VolumeGroupID).(string)
+
+	body, err := expandVolumeGroupAction(d.Get(Arg_VolumeGroupAction).([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := newPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	err = client.VolumeGroupAction(vgID, body)
+	if err != nil {
+		log.Printf("[DEBUG] volume group action failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, vgID))
+
+	_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIVolumeGroupActionRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeGroupActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, vgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := newPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	vg, err := client.Get(vgID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_volume_groups.PcloudVolumegroupsGetNotFound:
+			log.Printf("[DEBUG] volume group does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set(Attr_VolumeGroupStatus, vg.Status)
+	d.Set(Attr_ReplicationStatus, vg.ReplicationStatus)
+	d.Set(Attr_StatusDescriptionErrors, flattenVolumeGroupStatusDescriptionErrors(vg.StatusDescription))
+
+	return nil
+}
+
+func resourceIBMPIVolumeGroupActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// There is no delete or unset concept for a volume group action
+	d.SetId("")
+	return nil
+}
+
+func expandVolumeGroupAction(data []interface{}) (*models.VolumeGroupAction, error) {
+	if len(data) == 0 || data[0] == nil {
+		return nil, fmt.Errorf("[ERROR] one of %s, %s or %s must be provided in %s", Attr_Start, Attr_Stop, Attr_Reset, Arg_VolumeGroupAction)
+	}
+	action := data[0].(map[string]interface{})
+
+	body := &models.VolumeGroupAction{}
+	count := 0
+	if v, ok := action[Attr_Start]; ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		start := v.([]interface{})[0].(map[string]interface{})
+		source := start[Attr_Source].(string)
+		body.Start = &models.VolumeGroupActionStart{Source: &source}
+		count++
+	}
+	if v, ok := action[Attr_Stop]; ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		stop := v.([]interface{})[0].(map[string]interface{})
+		access := stop[Attr_Access].(bool)
+		body.Stop = &models.VolumeGroupActionStop{Access: &access}
+		count++
+	}
+	if v, ok := action[Attr_Reset]; ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		reset := v.([]interface{})[0].(map[string]interface{})
+		status := reset[Attr_Status].(string)
+		body.Reset = &models.VolumeGroupActionReset{Status: &status}
+		count++
+	}
+	if count != 1 {
+		return nil, fmt.Errorf("[ERROR] exactly one of %s, %s or %s must be provided in %s", Attr_Start, Attr_Stop, Attr_Reset, Arg_VolumeGroupAction)
+	}
+	return body, nil
+}
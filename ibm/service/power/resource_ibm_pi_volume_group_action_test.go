@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMPIVolumeGroupActionbasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-volume-group-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumeGroupActionConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_group_action.power_volume_group_action", "volume_group_status", "available"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_pi_volume_group_action.power_volume_group_action", "replication_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumeGroupActionConfig(name string) string {
+	return testAccCheckIBMPIVolumeGroupConfig(name, "[ibm_pi_volume.power_volume_1.volume_id]") + fmt.Sprintf(`
+	resource "ibm_pi_volume_group_action" "power_volume_group_action" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_volume_group_id   = ibm_pi_volume_group.power_volume_group.volume_group_id
+		pi_volume_group_action {
+			stop {
+				access = false
+			}
+		}
+	}
+	`, acc.Pi_cloud_instance_id)
+}
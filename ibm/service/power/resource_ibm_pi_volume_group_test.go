@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volume_groups"
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func TestAccIBMPIVolumeGroupbasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-volume-group-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIVolumeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumeGroupConfig(name, "[ibm_pi_volume.power_volume_1.volume_id]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeGroupExists("ibm_pi_volume_group.power_volume_group"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_group.power_volume_group", "pi_volume_group_name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_group.power_volume_group", "pi_volume_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_pi_volume_group.power_volume_group", "volume_group_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIVolumeGroupConfig(name, "[ibm_pi_volume.power_volume_1.volume_id, ibm_pi_volume.power_volume_2.volume_id]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeGroupExists("ibm_pi_volume_group.power_volume_group"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_group.power_volume_group", "pi_volume_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumeGroupDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_volume_group" {
+			continue
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		params := p_cloud_volume_groups.NewPcloudVolumegroupsGetParams().
+			WithContext(context.Background()).
+			WithCloudInstanceID(parts[0]).WithVolumeGroupID(parts[1])
+		_, err = sess.Power.PCloudVolumeGroups.PcloudVolumegroupsGet(params, sess.AuthInfo(parts[0]))
+		if err == nil {
+			return fmt.Errorf("PI volume group still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMPIVolumeGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		params := p_cloud_volume_groups.NewPcloudVolumegroupsGetParams().
+			WithContext(context.Background()).
+			WithCloudInstanceID(parts[0]).WithVolumeGroupID(parts[1])
+		_, err = sess.Power.PCloudVolumeGroups.PcloudVolumegroupsGet(params, sess.AuthInfo(parts[0]))
+		return err
+	}
+}
+
+func testAccCheckIBMPIVolumeGroupConfig(name, volumeIDs string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_volume" "power_volume_1" {
+		pi_volume_size         = 20
+		pi_volume_name         = "%[2]s-1"
+		pi_volume_type         = "tier1"
+		pi_volume_shareable    = true
+		pi_replication_enabled = true
+		pi_cloud_instance_id   = "%[1]s"
+	}
+	resource "ibm_pi_volume" "power_volume_2" {
+		pi_volume_size         = 20
+		pi_volume_name         = "%[2]s-2"
+		pi_volume_type         = "tier1"
+		pi_volume_shareable    = true
+		pi_replication_enabled = true
+		pi_cloud_instance_id   = "%[1]s"
+	}
+	resource "ibm_pi_volume_group" "power_volume_group" {
+		pi_volume_group_name = "%[2]s"
+		pi_cloud_instance_id = "%[1]s"
+		pi_volume_ids        = %[3]s
+	}
+	`, acc.Pi_cloud_instance_id, name, volumeIDs)
+}
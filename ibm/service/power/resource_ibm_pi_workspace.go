@@ -0,0 +1,440 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_instances"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/resourcecontroller"
+)
+
+func ResourceIBMPIWorkspace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIWorkspaceCreate,
+		ReadContext:   resourceIBMPIWorkspaceRead,
+		UpdateContext: resourceIBMPIWorkspaceUpdate,
+		DeleteContext: resourceIBMPIWorkspaceDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_Name: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Workspace name",
+			},
+			Arg_Datacenter: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Datacenter (zone) where the workspace is created, for example dal12",
+			},
+			Arg_ResourceGroupID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Resource group ID of the workspace",
+			},
+
+			// Optional Arguments
+			Arg_Plan: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      WorkspaceDefaultPlan,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Plan name of the power-iaas service",
+			},
+
+			// Attributes
+			Attr_CRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CRN of the workspace",
+			},
+			Attr_Status: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource controller state of the workspace",
+			},
+			Attr_Capabilities: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Capabilities of the workspace",
+			},
+			Attr_StorageTypes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Storage types available in the workspace",
+			},
+			Attr_SAPSupported: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if SAP profiles are available in the workspace",
+			},
+		},
+	}
+}
+
+func resourceIBMPIWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rsCatRepo := rsCatClient.ResourceCatalog()
+
+	name := d.Get(Arg_Name).(string)
+	datacenter := d.Get(Arg_Datacenter).(string)
+	resourceGroupID := d.Get(Arg_ResourceGroupID).(string)
+	plan := d.Get(Arg_Plan).(string)
+
+	serviceOff, err := rsCatRepo.FindByName(WorkspaceServiceName, true)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error retrieving service offering %s: %s", WorkspaceServiceName, err)
+	}
+	if len(serviceOff) == 0 {
+		return diag.Errorf("[ERROR] Service offering %s not found in the catalog", WorkspaceServiceName)
+	}
+	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error retrieving plan %s: %s", plan, err)
+	}
+	deployments, err := rsCatRepo.ListDeployments(servicePlan)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error retrieving deployment for plan %s: %s", plan, err)
+	}
+	deployments, supportedLocations := resourcecontroller.FilterDeployments(deployments, datacenter)
+	if len(deployments) == 0 {
+		locationList := make([]string, 0, len(supportedLocations))
+		for l := range supportedLocations {
+			locationList = append(locationList, l)
+		}
+		return diag.Errorf("[ERROR] No deployment found for plan %s at datacenter %s, valid datacenter(s) are: %q", plan, datacenter, locationList)
+	}
+
+	rsInst := rc.CreateResourceInstanceOptions{
+		Name:           &name,
+		Target:         &deployments[0].CatalogCRN,
+		ResourceGroup:  &resourceGroupID,
+		ResourcePlanID: &servicePlan,
+	}
+	instance, resp, err := rsConClient.CreateResourceInstanceWithContext(ctx, &rsInst)
+	if err != nil {
+		log.Printf("[DEBUG] create workspace failed %v", err)
+		return diag.Errorf("[ERROR] Error creating workspace %s: %s with resp code: %s", name, err, resp)
+	}
+
+	d.SetId(*instance.GUID)
+
+	_, err = isWaitForIBMPIWorkspaceAvailable(ctx, d, meta, *instance.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIWorkspaceRead(ctx, d, meta)
+}
+
+func resourceIBMPIWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Id()
+	instance, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &rc.GetResourceInstanceOptions{ID: &id})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error retrieving workspace %s: %s with resp code: %s", id, err, resp)
+	}
+	if instance.State != nil && (*instance.State == WorkspaceStateRemoved || *instance.State == resourcecontroller.RsInstanceReclamation) {
+		log.Printf("[WARN] Removing workspace %s from state because it's in removed or pending_reclamation state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(Arg_Name, instance.Name)
+	d.Set(Arg_ResourceGroupID, instance.ResourceGroupID)
+	d.Set(Attr_CRN, instance.CRN)
+	d.Set(Attr_Status, instance.State)
+	if instance.RegionID != nil {
+		d.Set(Arg_Datacenter, instance.RegionID)
+	}
+
+	// The capabilities are read from the datacenter of the workspace; when one of them cannot be
+	// read the previous value is kept so that the workspace itself stays manageable
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cloudInstance, err := st.NewIBMPICloudInstanceClient(ctx, sess, id).Get(id)
+	if err != nil {
+		log.Printf("[WARN] get capabilities of workspace %s failed %v", id, err)
+	} else {
+		d.Set(Attr_Capabilities, cloudInstance.Capabilities)
+	}
+
+	storageTypes, err := workspaceStorageTypes(ctx, sess, id)
+	if err != nil {
+		log.Printf("[WARN] get storage types of workspace %s failed %v", id, err)
+	} else {
+		d.Set(Attr_StorageTypes, storageTypes)
+	}
+
+	sapProfiles, err := st.NewIBMPISAPInstanceClient(ctx, sess, id).GetAllSAPProfiles(id)
+	if err != nil {
+		log.Printf("[WARN] get SAP profiles of workspace %s failed %v", id, err)
+	} else {
+		d.Set(Attr_SAPSupported, len(sapProfiles.Profiles) > 0)
+	}
+
+	return nil
+}
+
+func resourceIBMPIWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(Arg_Name) {
+		rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		id := d.Id()
+		name := d.Get(Arg_Name).(string)
+		_, resp, err := rsConClient.UpdateResourceInstanceWithContext(ctx, &rc.UpdateResourceInstanceOptions{
+			ID:   &id,
+			Name: &name,
+		})
+		if err != nil {
+			return diag.Errorf("[ERROR] Error updating workspace %s: %s with resp code: %s", id, err, resp)
+		}
+	}
+
+	return resourceIBMPIWorkspaceRead(ctx, d, meta)
+}
+
+func resourceIBMPIWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Id()
+	recursive := true
+	resp, err := rsConClient.DeleteResourceInstanceWithContext(ctx, &rc.DeleteResourceInstanceOptions{
+		ID:        &id,
+		Recursive: &recursive,
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 410 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error deleting workspace %s: %s with resp code: %s", id, err, resp)
+	}
+
+	_, err = isWaitForIBMPIWorkspaceDeleted(ctx, rsConClient, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// isWaitForIBMPIWorkspaceAvailable waits for the resource controller instance to become active and
+// then for the power cloud instance to be enabled and initialized
+func isWaitForIBMPIWorkspaceAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for workspace (%s) to be available.", id)
+
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{resourcecontroller.RsInstanceProgressStatus, resourcecontroller.RsInstanceInactiveStatus, resourcecontroller.RsInstanceProvisioningStatus},
+		Target:  []string{WorkspaceStateActive},
+		Refresh: func() (interface{}, string, error) {
+			instance, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &rc.GetResourceInstanceOptions{ID: &id})
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Get workspace %s failed with resp code: %s, err: %v", id, resp, err)
+			}
+			if *instance.State == WorkspaceStateFailed {
+				return instance, *instance.State, fmt.Errorf("[ERROR] The workspace %s failed to provision", id)
+			}
+			return instance, *instance.State, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return nil, err
+	}
+	guid := d.Id()
+	client := st.NewIBMPICloudInstanceClient(ctx, sess, guid)
+
+	stateConf = &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{WorkspaceStateActive},
+		Refresh: func() (interface{}, string, error) {
+			cloudInstance, err := client.Get(guid)
+			if err != nil {
+				// the power cloud instance is not visible until it is registered with the datacenter
+				uErr := errors.Unwrap(err)
+				switch uErr.(type) {
+				case *p_cloud_instances.PcloudCloudinstancesGetNotFound:
+					log.Printf("[DEBUG] workspace %s not yet available: %v", guid, err)
+					return guid, "pending", nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Get workspace %s from the datacenter failed, check that the provider zone matches %s: %v", guid, Arg_Datacenter, err)
+			}
+			if cloudInstance.Enabled != nil && *cloudInstance.Enabled && cloudInstance.Initialized != nil && *cloudInstance.Initialized {
+				return cloudInstance, WorkspaceStateActive, nil
+			}
+			return cloudInstance, "pending", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isWaitForIBMPIWorkspaceDeleted(ctx context.Context, rsConClient *rc.ResourceControllerV2, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for workspace (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{resourcecontroller.RsInstanceProgressStatus, resourcecontroller.RsInstanceInactiveStatus, WorkspaceStateActive},
+		Target:  []string{WorkspaceStateRemoved, resourcecontroller.RsInstanceReclamation},
+		Refresh: func() (interface{}, string, error) {
+			instance, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &rc.GetResourceInstanceOptions{ID: &id})
+			if err != nil {
+				if resp != nil && resp.StatusCode == 404 {
+					return id, WorkspaceStateRemoved, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Get workspace %s failed with resp code: %s, err: %v", id, resp, err)
+			}
+			if *instance.State == WorkspaceStateFailed {
+				return instance, *instance.State, fmt.Errorf("[ERROR] The workspace %s failed to delete", id)
+			}
+			return instance, *instance.State, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+// workspaceStorageTypes returns the storage types that have capacity in the workspace
+func workspaceStorageTypes(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID string) ([]string, error) {
+	capacity, err := st.NewIBMPIStorageCapacityClient(ctx, sess, cloudInstanceID).GetAllStorageTypesCapacity()
+	if err != nil {
+		return nil, err
+	}
+	storageTypes := make([]string, 0, len(capacity.StorageTypesCapacity))
+	for _, stc := range capacity.StorageTypesCapacity {
+		if stc != nil && stc.StorageType != "" {
+			storageTypes = append(storageTypes, stc.StorageType)
+		}
+	}
+	return storageTypes, nil
+}
+
+// workspaceCapabilitiesCustomizeDiff rejects during plan a storage type that is not available in the
+// workspace and an SAP profile in a workspace without SAP support. An empty sapKey skips the SAP check.
+func workspaceCapabilitiesCustomizeDiff(storageTypeKey, sapKey string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		checkStorageType := diff.NewValueKnown(storageTypeKey) && diff.Get(storageTypeKey).(string) != "" &&
+			(diff.Id() == "" || diff.HasChange(storageTypeKey))
+		checkSAP := sapKey != "" && diff.NewValueKnown(sapKey) && diff.Get(sapKey).(string) != "" &&
+			(diff.Id() == "" || diff.HasChange(sapKey))
+		if !checkStorageType && !checkSAP {
+			return nil
+		}
+		if !diff.NewValueKnown(helpers.PICloudInstanceId) {
+			return nil
+		}
+		cloudInstanceID := diff.Get(helpers.PICloudInstanceId).(string)
+		if cloudInstanceID == "" {
+			return nil
+		}
+
+		sess, err := meta.(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+
+		if checkStorageType {
+			storageType := diff.Get(storageTypeKey).(string)
+			storageTypes, err := workspaceStorageTypes(ctx, sess, cloudInstanceID)
+			if err != nil {
+				return fmt.Errorf("[ERROR] failed to get the storage types of workspace %s to validate %s: %v", cloudInstanceID, storageTypeKey, err)
+			}
+			found := false
+			for _, t := range storageTypes {
+				if t == storageType {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("[ERROR] %s %s is not available in workspace %s, available storage types are: %q", storageTypeKey, storageType, cloudInstanceID, storageTypes)
+			}
+		}
+
+		if checkSAP {
+			sapProfiles, err := st.NewIBMPISAPInstanceClient(ctx, sess, cloudInstanceID).GetAllSAPProfiles(cloudInstanceID)
+			if err != nil {
+				return fmt.Errorf("[ERROR] failed to get the SAP profiles of workspace %s to validate %s: %v", cloudInstanceID, sapKey, err)
+			}
+			if len(sapProfiles.Profiles) == 0 {
+				return fmt.Errorf("[ERROR] workspace %s does not support SAP profiles, %s cannot be used", cloudInstanceID, sapKey)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func TestAccIBMPIWorkspacebasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-workspace-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIWorkspaceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIWorkspaceExists("ibm_pi_workspace.powervs_service_instance"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_workspace.powervs_service_instance", "pi_name", name),
+					resource.TestCheckResourceAttrSet(
+						"ibm_pi_workspace.powervs_service_instance", "crn"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_pi_workspace.powervs_service_instance", "storage_types.#"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIWorkspaceConfig(name + "-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_pi_workspace.powervs_service_instance", "pi_name", name+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIWorkspaceConfig(name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "group" {
+		is_default = true
+	}
+
+	resource "ibm_pi_workspace" "powervs_service_instance" {
+		pi_name              = "%[1]s"
+		pi_datacenter        = "%[2]s"
+		pi_resource_group_id = data.ibm_resource_group.group.id
+	}`, name, acc.Pi_workspace_datacenter)
+}
+
+func testAccCheckIBMPIWorkspaceDestroy(s *terraform.State) error {
+	rsConClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_workspace" {
+			continue
+		}
+		id := rs.Primary.ID
+		instance, resp, err := rsConClient.GetResourceInstance(&rc.GetResourceInstanceOptions{ID: &id})
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				continue
+			}
+			return err
+		}
+		if instance.State != nil && *instance.State != "removed" && *instance.State != "pending_reclamation" {
+			return fmt.Errorf("PI workspace still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMPIWorkspaceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		rsConClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
+		if err != nil {
+			return err
+		}
+		id := rs.Primary.ID
+		_, _, err = rsConClient.GetResourceInstance(&rc.GetResourceInstanceOptions{ID: &id})
+		return err
+	}
+}
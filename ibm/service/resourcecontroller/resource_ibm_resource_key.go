@@ -4,6 +4,7 @@
 package resourcecontroller
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 )
 
@@ -33,7 +35,7 @@ func ResourceIBMResourceKey() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: secretsmanager.CredentialsSinkSchema(map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -73,7 +75,7 @@ func ResourceIBMResourceKey() *schema.Resource {
 			},
 
 			"credentials": {
-				Description: "Credentials asociated with the key",
+				Description: "Credentials asociated with the key. Not set when the credentials are written to credentials_output_path or credentials_secrets_manager",
 				Type:        schema.TypeMap,
 				Sensitive:   true,
 				Computed:    true,
@@ -186,7 +188,7 @@ func ResourceIBMResourceKey() *schema.Resource {
 				Computed:    true,
 				Description: "The subject who deleted the key.",
 			},
-		},
+		}),
 	}
 }
 
@@ -261,6 +263,20 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("[ERROR] Error creating resource key: %s with resp code: %s", err, resp)
 	}
 
+	// the ID is only committed once the credentials are written, a resource key whose credentials were lost is deleted
+	if secretsmanager.CredentialsSinkEnabled(d) {
+		creds, err := json.Marshal(resourceKey.Credentials)
+		if err == nil {
+			err = secretsmanager.WriteCredentialsToSink(context.Background(), d, meta, string(creds))
+		}
+		if err != nil {
+			if resp, deleteErr := rsContClient.DeleteResourceKey(&rc.DeleteResourceKeyOptions{ID: resourceKey.ID}); deleteErr != nil {
+				log.Printf("[WARN] Error deleting resource key %s: %s with resp code: %s", *resourceKey.ID, deleteErr, resp)
+			}
+			return fmt.Errorf("[ERROR] Error writing the resource key credentials: %s", err)
+		}
+	}
+
 	d.SetId(*resourceKey.ID)
 
 	return resourceIBMResourceKeyRead(d, meta)
@@ -284,17 +300,20 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil || resourceKey == nil {
 		return fmt.Errorf("[ERROR] Error retrieving resource key: %s with resp : %s", err, resp)
 	}
-	var credInterface map[string]interface{}
-	cred, _ := json.Marshal(resourceKey.Credentials)
-	json.Unmarshal(cred, &credInterface)
-	d.Set("credentials", flex.Flatten(credInterface))
+	// credentials written to a sink are not kept in state
+	if !secretsmanager.CredentialsSinkEnabled(d) {
+		var credInterface map[string]interface{}
+		cred, _ := json.Marshal(resourceKey.Credentials)
+		json.Unmarshal(cred, &credInterface)
+		d.Set("credentials", flex.Flatten(credInterface))
 
-	creds, err := json.Marshal(resourceKey.Credentials)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error marshalling resource key credentials: %s", err)
-	}
-	if err = d.Set("credentials_json", string(creds)); err != nil {
-		return fmt.Errorf("[ERROR] Error setting the credentials json: %s", err)
+		creds, err := json.Marshal(resourceKey.Credentials)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error marshalling resource key credentials: %s", err)
+		}
+		if err = d.Set("credentials_json", string(creds)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting the credentials json: %s", err)
+		}
 	}
 	d.Set("name", *resourceKey.Name)
 	d.Set("status", *resourceKey.State)
@@ -384,6 +403,9 @@ func resourceIBMResourceKeyDelete(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting resource key: %s with resp code: %s", err, resp)
 	}
+	if err = secretsmanager.DeleteCredentialsFromSink(context.Background(), d, meta); err != nil {
+		return err
+	}
 
 	d.SetId("")
 
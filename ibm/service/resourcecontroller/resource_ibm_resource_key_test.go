@@ -47,6 +47,28 @@ func TestAccIBMResourceKey_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceKey_CredentialsSecretsManager(t *testing.T) {
+	resourceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+	resourceKey := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceKeyCredentialsSecretsManager(resourceName, resourceKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceKeyExists("ibm_resource_key.resourceKey"),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "credentials.%", "0"),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "credentials_json", ""),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "credentials_secrets_manager.0.secret_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMResourceKey_With_Tags(t *testing.T) {
 	resourceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
 	resourceKey := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
@@ -197,6 +219,26 @@ func testAccCheckIBMResourceKeyBasic(resourceName, resourceKey string) string {
 	`, resourceName, resourceKey)
 }
 
+func testAccCheckIBMResourceKeyCredentialsSecretsManager(resourceName, resourceKey string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "resource" {
+			name              = "%[1]s"
+			service           = "cloud-object-storage"
+			plan              = "standard"
+			location          = "global"
+		}
+		resource "ibm_resource_key" "resourceKey" {
+			name = "%[2]s"
+			resource_instance_id = ibm_resource_instance.resource.id
+			role = "Reader"
+			credentials_secrets_manager {
+				instance_id = "%[3]s"
+				secret_name = "%[2]s"
+			}
+		}
+	`, resourceName, resourceKey, acc.SecretsManagerInstanceID)
+}
+
 func testAccCheckIBMResourceKeyWithCustomRole(resourceName, resourceKey, crName, displayName string) string {
 	return fmt.Sprintf(`
 		
@@ -363,7 +363,7 @@ func dataSourceIBMSecretsManagerSecretRead(context context.Context, d *schema.Re
 					d.Set("next_rotation_date", (*ritem.NextRotationDate).String())
 				}
 				if ritem.TTL != nil {
-					d.Set("ttl", fmt.Sprintf("%v", ritem.TTL))
+					d.Set("ttl", flattenSecretTTL(ritem.TTL))
 				}
 				if ritem.AccessGroups != nil {
 					d.Set("access_groups", ritem.AccessGroups)
@@ -458,7 +458,7 @@ func dataSourceListSecretsResourcesToMap(resourcesItem secretsmanagerv1.SecretRe
 		resourcesMap["next_rotation_date"] = (*resourcesItem.NextRotationDate).String()
 	}
 	if resourcesItem.TTL != nil {
-		resourcesMap["ttl"] = fmt.Sprintf("%v", resourcesItem.TTL)
+		resourcesMap["ttl"] = flattenSecretTTL(resourcesItem.TTL)
 	}
 	if resourcesItem.AccessGroups != nil {
 		resourcesMap["access_groups"] = resourcesItem.AccessGroups
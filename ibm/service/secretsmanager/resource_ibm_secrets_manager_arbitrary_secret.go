@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const arbitrarySecretType = secretsmanagerv1.CreateSecretOptionsSecretTypeArbitraryConst
+
+func ResourceIBMSecretsManagerArbitrarySecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerArbitrarySecretCreate,
+		ReadContext:   resourceIBMSecretsManagerArbitrarySecretRead,
+		UpdateContext: resourceIBMSecretsManagerArbitrarySecretUpdate,
+		DeleteContext: resourceIBMSecretsManagerSecretDelete(arbitrarySecretType),
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: secretsManagerSecretSchema(map[string]*schema.Schema{
+			"payload": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The secret data to assign to the secret. Changing it rotates the secret.",
+			},
+			"expiration_date": expirationDateSchema(),
+		}),
+	}
+}
+
+func resourceIBMSecretsManagerArbitrarySecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := getSecretsManagerClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretResource := &secretsmanagerv1.SecretResourceArbitrarySecretResource{
+		Name:    core.StringPtr(d.Get("name").(string)),
+		Labels:  flex.ExpandStringList(d.Get("labels").([]interface{})),
+		Payload: core.StringPtr(d.Get("payload").(string)),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		secretResource.Description = core.StringPtr(description.(string))
+	}
+	if secretGroupID, ok := d.GetOk("secret_group_id"); ok {
+		secretResource.SecretGroupID = core.StringPtr(secretGroupID.(string))
+	}
+	secretResource.ExpirationDate, err = parseExpirationDate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretID, err := createSecretsManagerSecret(context, secretsManagerClient, arbitrarySecretType, secretResource)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(getSecretsManagerID(d.Get("instance_id").(string), secretID))
+
+	return resourceIBMSecretsManagerArbitrarySecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerArbitrarySecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, diags := readSecretsManagerSecret(context, d, meta, arbitrarySecretType)
+	if diags != nil || secret == nil {
+		return diags
+	}
+
+	if secret.ExpirationDate != nil {
+		d.Set("expiration_date", secret.ExpirationDate.String())
+	}
+	if secretData, ok := secret.SecretData.(map[string]interface{}); ok {
+		if payload, ok := secretData["payload"].(string); ok {
+			d.Set("payload", payload)
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerArbitrarySecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := getSecretsManagerClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateSecretsManagerSecretMetadata(context, d, secretsManagerClient, arbitrarySecretType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("payload") {
+		action := &secretsmanagerv1.SecretActionOneOfRotateArbitrarySecretBody{
+			Payload: core.StringPtr(d.Get("payload").(string)),
+		}
+		err = rotateSecretsManagerSecret(context, d, secretsManagerClient, arbitrarySecretType, action)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerArbitrarySecretRead(context, d, meta)
+}
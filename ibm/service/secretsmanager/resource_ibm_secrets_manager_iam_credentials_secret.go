@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const iamCredentialsSecretType = secretsmanagerv1.CreateSecretOptionsSecretTypeIamCredentialsConst
+
+func ResourceIBMSecretsManagerIamCredentialsSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerIamCredentialsSecretCreate,
+		ReadContext:   resourceIBMSecretsManagerIamCredentialsSecretRead,
+		UpdateContext: resourceIBMSecretsManagerIamCredentialsSecretUpdate,
+		DeleteContext: resourceIBMSecretsManagerSecretDelete(iamCredentialsSecretType),
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: secretsManagerSecretSchema(map[string]*schema.Schema{
+			"access_groups": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				MaxItems:    10,
+				Description: "The access groups that define the capabilities of the service ID and API key that are generated for the secret.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ttl": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateSecretTTL,
+				DiffSuppressFunc: suppressEquivalentSecretTTL,
+				Description:      "The time-to-live or lease duration of the generated API keys, either a number of seconds or a duration such as 120m or 24h.",
+			},
+			"reuse_api_key": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the service ID and API key are reused until the secret is rotated, instead of being generated each time the secret is read.",
+			},
+			"api_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key that is generated for the secret.",
+			},
+			"service_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The service ID under which the API key is created.",
+			},
+			"next_rotation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date that the secret is scheduled for automatic rotation. The date format follows RFC 3339.",
+			},
+		}),
+	}
+}
+
+func resourceIBMSecretsManagerIamCredentialsSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := getSecretsManagerClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretResource := &secretsmanagerv1.SecretResourceIamSecretResource{
+		Name:         core.StringPtr(d.Get("name").(string)),
+		Labels:       flex.ExpandStringList(d.Get("labels").([]interface{})),
+		AccessGroups: flex.ExpandStringList(d.Get("access_groups").([]interface{})),
+		TTL:          d.Get("ttl").(string),
+		ReuseAPIKey:  core.BoolPtr(d.Get("reuse_api_key").(bool)),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		secretResource.Description = core.StringPtr(description.(string))
+	}
+	if secretGroupID, ok := d.GetOk("secret_group_id"); ok {
+		secretResource.SecretGroupID = core.StringPtr(secretGroupID.(string))
+	}
+
+	secretID, err := createSecretsManagerSecret(context, secretsManagerClient, iamCredentialsSecretType, secretResource)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(getSecretsManagerID(d.Get("instance_id").(string), secretID))
+
+	return resourceIBMSecretsManagerIamCredentialsSecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerIamCredentialsSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, diags := readSecretsManagerSecret(context, d, meta, iamCredentialsSecretType)
+	if diags != nil || secret == nil {
+		return diags
+	}
+
+	d.Set("access_groups", secret.AccessGroups)
+	if secret.TTL != nil {
+		d.Set("ttl", flattenSecretTTL(secret.TTL))
+	}
+	if secret.ReuseAPIKey != nil {
+		d.Set("reuse_api_key", *secret.ReuseAPIKey)
+	}
+	d.Set("api_key", secret.APIKey)
+	d.Set("service_id", secret.ServiceID)
+	if secret.NextRotationDate != nil {
+		d.Set("next_rotation_date", secret.NextRotationDate.String())
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerIamCredentialsSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := getSecretsManagerClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateSecretsManagerSecretMetadata(context, d, secretsManagerClient, iamCredentialsSecretType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerIamCredentialsSecretRead(context, d, meta)
+}
+
+// parseSecretTTL returns the duration of a TTL given as a number of seconds or as a duration string
+func parseSecretTTL(ttl string) (time.Duration, error) {
+	if seconds, err := strconv.ParseInt(ttl, 10, 64); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return time.ParseDuration(ttl)
+}
+
+func validateSecretTTL(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := parseSecretTTL(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a number of seconds or a duration such as 120m or 24h, got %q", k, v))
+		return
+	}
+	if duration < time.Minute || duration > 90*24*time.Hour {
+		errors = append(errors, fmt.Errorf("%q must be between 1 minute and 90 days, got %q", k, v))
+	}
+	return
+}
+
+// flattenSecretTTL formats the TTL returned by the API, a number of seconds is decoded as a float64
+// that %v would print in exponent notation
+func flattenSecretTTL(ttl interface{}) string {
+	switch v := ttl.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// suppressEquivalentSecretTTL ignores the difference between the TTL in the configuration and the number of seconds returned by the API
+func suppressEquivalentSecretTTL(k, old, new string, d *schema.ResourceData) bool {
+	oldDuration, err := parseSecretTTL(old)
+	if err != nil {
+		return false
+	}
+	newDuration, err := parseSecretTTL(new)
+	if err != nil {
+		return false
+	}
+	return oldDuration == newDuration
+}
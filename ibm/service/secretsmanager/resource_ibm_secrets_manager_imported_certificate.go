@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const importedCertificateSecretType = "imported_cert"
+
+// importedCertificateFields are the fields of the imported certificate model, which the Secrets Manager SDK does not provide
+type importedCertificateFields struct {
+	Certificate  *string `json:"certificate"`
+	PrivateKey   *string `json:"private_key,omitempty"`
+	Intermediate *string `json:"intermediate,omitempty"`
+}
+
+type importedCertificateSecretResource struct {
+	*secretsmanagerv1.SecretResource
+	importedCertificateFields
+}
+
+// importedCertificateRotateBody is the rotate action of an imported certificate
+type importedCertificateRotateBody struct {
+	*secretsmanagerv1.SecretActionOneOf
+	importedCertificateFields
+}
+
+func ResourceIBMSecretsManagerImportedCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerImportedCertificateCreate,
+		ReadContext:   resourceIBMSecretsManagerImportedCertificateRead,
+		UpdateContext: resourceIBMSecretsManagerImportedCertificateUpdate,
+		DeleteContext: resourceIBMSecretsManagerSecretDelete(importedCertificateSecretType),
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: secretsManagerSecretSchema(map[string]*schema.Schema{
+			"certificate": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PEM encoded contents of your certificate. Changing it rotates the secret.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded private key to associate with the certificate. Changing it rotates the secret.",
+			},
+			"intermediate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The PEM encoded intermediate certificate to associate with the root certificate. Changing it rotates the secret.",
+			},
+			"expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the certificate expires. The date format follows RFC 3339.",
+			},
+		}),
+	}
+}
+
+func expandImportedCertificateFields(d *schema.ResourceData) importedCertificateFields {
+	fields := importedCertificateFields{
+		Certificate: core.StringPtr(d.Get("certificate").(string)),
+	}
+	if privateKey, ok := d.GetOk("private_key"); ok {
+		fields.PrivateKey = core.StringPtr(privateKey.(string))
+	}
+	if intermediate, ok := d.GetOk("intermediate"); ok {
+		fields.Intermediate = core.StringPtr(intermediate.(string))
+	}
+	return fields
+}
+
+func resourceIBMSecretsManagerImportedCertificateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := getSecretsManagerClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretResource := &importedCertificateSecretResource{
+		SecretResource: &secretsmanagerv1.SecretResource{
+			Name:   core.StringPtr(d.Get("name").(string)),
+			Labels: flex.ExpandStringList(d.Get("labels").([]interface{})),
+		},
+		importedCertificateFields: expandImportedCertificateFields(d),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		secretResource.Description = core.StringPtr(description.(string))
+	}
+	if secretGroupID, ok := d.GetOk("secret_group_id"); ok {
+		secretResource.SecretGroupID = core.StringPtr(secretGroupID.(string))
+	}
+
+	secretID, err := createSecretsManagerSecret(context, secretsManagerClient, importedCertificateSecretType, secretResource)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(getSecretsManagerID(d.Get("instance_id").(string), secretID))
+
+	return resourceIBMSecretsManagerImportedCertificateRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerImportedCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, diags := readSecretsManagerSecret(context, d, meta, importedCertificateSecretType)
+	if diags != nil || secret == nil {
+		return diags
+	}
+
+	if secret.ExpirationDate != nil {
+		d.Set("expiration_date", secret.ExpirationDate.String())
+	}
+	if secretData, ok := secret.SecretData.(map[string]interface{}); ok {
+		for _, key := range []string{"certificate", "private_key", "intermediate"} {
+			if value, ok := secretData[key].(string); ok {
+				d.Set(key, value)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerImportedCertificateUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := getSecretsManagerClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateSecretsManagerSecretMetadata(context, d, secretsManagerClient, importedCertificateSecretType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChanges("certificate", "private_key", "intermediate") {
+		action := &importedCertificateRotateBody{
+			SecretActionOneOf:         &secretsmanagerv1.SecretActionOneOf{},
+			importedCertificateFields: expandImportedCertificateFields(d),
+		}
+		err = rotateSecretsManagerSecret(context, d, secretsManagerClient, importedCertificateSecretType, action)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerImportedCertificateRead(context, d, meta)
+}
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerImportedCertificateBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-imported-cert-%d", acctest.RandIntRange(10, 100))
+	certificate, privateKey := testAccIBMSecretsManagerSelfSignedCertificate(t, "first.example.com")
+	rotatedCertificate, rotatedPrivateKey := testAccIBMSecretsManagerSelfSignedCertificate(t, "rotated.example.com")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerImportedCertificateConfig(name, certificate, privateKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_imported_certificate.cert", "certificate", certificate),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_imported_certificate.cert", "expiration_date"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerImportedCertificateConfig(name, rotatedCertificate, rotatedPrivateKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_imported_certificate.cert", "certificate", rotatedCertificate),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerImportedCertificateConfig(name, certificate, privateKey string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_imported_certificate" "cert" {
+			instance_id = "%s"
+			name        = "%s"
+			certificate = <<EOT
+%sEOT
+			private_key = <<EOT
+%sEOT
+		}
+	`, acc.SecretsManagerInstanceID, name, certificate, privateKey)
+}
+
+func testAccIBMSecretsManagerSelfSignedCertificate(t *testing.T, commonName string) (string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().AddDate(1, 0, 0),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return string(certificate), string(privateKey)
+}
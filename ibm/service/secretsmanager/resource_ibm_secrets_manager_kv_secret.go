@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const kvSecretType = "kv"
+
+// kvSecretResource is the key-value secret model, which the Secrets Manager SDK does not provide.
+// Its payload is an object, it replaces the string payload of the generic model in the request body.
+type kvSecretResource struct {
+	*secretsmanagerv1.SecretResource
+	Payload map[string]interface{} `json:"payload"`
+}
+
+// kvSecretRotateBody is the rotate action of a key-value secret
+type kvSecretRotateBody struct {
+	*secretsmanagerv1.SecretActionOneOf
+	Payload map[string]interface{} `json:"payload"`
+}
+
+func ResourceIBMSecretsManagerKvSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerKvSecretCreate,
+		ReadContext:   resourceIBMSecretsManagerKvSecretRead,
+		UpdateContext: resourceIBMSecretsManagerKvSecretUpdate,
+		DeleteContext: resourceIBMSecretsManagerSecretDelete(kvSecretType),
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: secretsManagerSecretSchema(map[string]*schema.Schema{
+			"data": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The key-value pairs to assign to the secret. Changing them rotates the secret.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		}),
+	}
+}
+
+func resourceIBMSecretsManagerKvSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := getSecretsManagerClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretResource := &kvSecretResource{
+		SecretResource: &secretsmanagerv1.SecretResource{
+			Name:   core.StringPtr(d.Get("name").(string)),
+			Labels: flex.ExpandStringList(d.Get("labels").([]interface{})),
+		},
+		Payload: d.Get("data").(map[string]interface{}),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		secretResource.Description = core.StringPtr(description.(string))
+	}
+	if secretGroupID, ok := d.GetOk("secret_group_id"); ok {
+		secretResource.SecretGroupID = core.StringPtr(secretGroupID.(string))
+	}
+
+	secretID, err := createSecretsManagerSecret(context, secretsManagerClient, kvSecretType, secretResource)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(getSecretsManagerID(d.Get("instance_id").(string), secretID))
+
+	return resourceIBMSecretsManagerKvSecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerKvSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, diags := readSecretsManagerSecret(context, d, meta, kvSecretType)
+	if diags != nil || secret == nil {
+		return diags
+	}
+
+	if secretData, ok := secret.SecretData.(map[string]interface{}); ok {
+		if payload, ok := secretData["payload"].(map[string]interface{}); ok {
+			data := make(map[string]interface{}, len(payload))
+			for k, v := range payload {
+				if s, ok := v.(string); ok {
+					data[k] = s
+				} else {
+					data[k] = fmt.Sprintf("%v", v)
+				}
+			}
+			d.Set("data", data)
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerKvSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := getSecretsManagerClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateSecretsManagerSecretMetadata(context, d, secretsManagerClient, kvSecretType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("data") {
+		action := &kvSecretRotateBody{
+			SecretActionOneOf: &secretsmanagerv1.SecretActionOneOf{},
+			Payload:           d.Get("data").(map[string]interface{}),
+		}
+		err = rotateSecretsManagerSecret(context, d, secretsManagerClient, kvSecretType, action)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerKvSecretRead(context, d, meta)
+}
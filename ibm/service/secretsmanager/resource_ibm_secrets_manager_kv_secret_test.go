@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerKvSecretBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-kv-secret-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerKvSecretConfig(name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_kv_secret.secret", "data.%", "2"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_kv_secret.secret", "data.token", "first"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerKvSecretConfig(name, "rotated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_kv_secret.secret", "data.token", "rotated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerKvSecretConfig(name, token string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_kv_secret" "secret" {
+			instance_id = "%s"
+			name        = "%s"
+			data = {
+				user  = "terraform"
+				token = "%s"
+			}
+		}
+	`, acc.SecretsManagerInstanceID, name, token)
+}
@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMSecretsManagerSecretGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerSecretGroupCreate,
+		ReadContext:   resourceIBMSecretsManagerSecretGroupRead,
+		UpdateContext: resourceIBMSecretsManagerSecretGroupUpdate,
+		DeleteContext: resourceIBMSecretsManagerSecretGroupDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: secretsManagerResourceSchema(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of your secret group.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An extended description of your secret group.To protect your privacy, do not use personal data, such as your name or location, as a description for your secret group.",
+			},
+			"secret_group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The v4 UUID that uniquely identifies the secret group.",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the secret group was created. The date format follows RFC 3339.",
+			},
+			"last_update_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Updates when the metadata of the secret group is modified. The date format follows RFC 3339.",
+			},
+		}),
+	}
+}
+
+func resourceIBMSecretsManagerSecretGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := getSecretsManagerClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretGroupResource := secretsmanagerv1.SecretGroupResource{
+		Name: core.StringPtr(d.Get("name").(string)),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		secretGroupResource.Description = core.StringPtr(description.(string))
+	}
+	createSecretGroupOptions := &secretsmanagerv1.CreateSecretGroupOptions{
+		Metadata:  secretGroupCollectionMetadata,
+		Resources: []secretsmanagerv1.SecretGroupResource{secretGroupResource},
+	}
+
+	secretGroup, response, err := secretsManagerClient.CreateSecretGroupWithContext(context, createSecretGroupOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretGroupWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateSecretGroupWithContext failed %s\n%s", err, response))
+	}
+	if len(secretGroup.Resources) == 0 || secretGroup.Resources[0].ID == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] CreateSecretGroupWithContext returned no secret group"))
+	}
+
+	d.SetId(getSecretsManagerID(d.Get("instance_id").(string), *secretGroup.Resources[0].ID))
+
+	return resourceIBMSecretsManagerSecretGroupRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerSecretGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	idParts, err := parseSecretsManagerID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, secretGroupID := idParts[0], idParts[1]
+	d.Set("instance_id", instanceID)
+
+	secretsManagerClient, err := getSecretsManagerClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getSecretGroupOptions := &secretsmanagerv1.GetSecretGroupOptions{
+		ID: core.StringPtr(secretGroupID),
+	}
+	secretGroup, response, err := secretsManagerClient.GetSecretGroupWithContext(context, getSecretGroupOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Secret group %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecretGroupWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecretGroupWithContext failed %s\n%s", err, response))
+	}
+	if len(secretGroup.Resources) == 0 {
+		return diag.FromErr(fmt.Errorf("[ERROR] GetSecretGroupWithContext returned no secret group %s", secretGroupID))
+	}
+
+	group := secretGroup.Resources[0]
+	d.Set("secret_group_id", secretGroupID)
+	d.Set("name", group.Name)
+	d.Set("description", group.Description)
+	if group.CreationDate != nil {
+		d.Set("creation_date", group.CreationDate.String())
+	}
+	if group.LastUpdateDate != nil {
+		d.Set("last_update_date", group.LastUpdateDate.String())
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerSecretGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := getSecretsManagerClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "description") {
+		updateSecretGroupMetadataOptions := &secretsmanagerv1.UpdateSecretGroupMetadataOptions{
+			ID:       core.StringPtr(d.Get("secret_group_id").(string)),
+			Metadata: secretGroupCollectionMetadata,
+			Resources: []secretsmanagerv1.SecretGroupMetadataUpdatable{
+				{
+					Name:        core.StringPtr(d.Get("name").(string)),
+					Description: core.StringPtr(d.Get("description").(string)),
+				},
+			},
+		}
+		_, response, err := secretsManagerClient.UpdateSecretGroupMetadataWithContext(context, updateSecretGroupMetadataOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateSecretGroupMetadataWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateSecretGroupMetadataWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMSecretsManagerSecretGroupRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerSecretGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := getSecretsManagerClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteSecretGroupOptions := &secretsmanagerv1.DeleteSecretGroupOptions{
+		ID: core.StringPtr(d.Get("secret_group_id").(string)),
+	}
+	response, err := secretsManagerClient.DeleteSecretGroupWithContext(context, deleteSecretGroupOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteSecretGroupWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteSecretGroupWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerSecretGroupBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-secret-group-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerSecretGroupConfig(name, "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_group.group", "name", name),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_group.group", "description", "created by terraform"),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_secret_group.group", "secret_group_id"),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_secret_group.group", "creation_date"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerSecretGroupConfig(name, "updated by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_group.group", "description", "updated by terraform"),
+				),
+			},
+			{
+				ResourceName:      "ibm_secrets_manager_secret_group.group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerSecretGroupConfig(name, description string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_secret_group" "group" {
+			instance_id = "%s"
+			name        = "%s"
+			description = "%s"
+		}
+	`, acc.SecretsManagerInstanceID, name, description)
+}
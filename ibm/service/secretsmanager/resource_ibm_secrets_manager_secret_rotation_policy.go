@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMSecretsManagerSecretRotationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerSecretRotationPolicyUpdate,
+		ReadContext:   resourceIBMSecretsManagerSecretRotationPolicyRead,
+		UpdateContext: resourceIBMSecretsManagerSecretRotationPolicyUpdate,
+		DeleteContext: resourceIBMSecretsManagerSecretRotationPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: secretsManagerResourceSchema(map[string]*schema.Schema{
+			"secret_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      secretsmanagerv1.PutPolicyOptionsSecretTypeUsernamePasswordConst,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{secretsmanagerv1.PutPolicyOptionsSecretTypeUsernamePasswordConst}),
+				Description:  "The secret type. Rotation policies are supported for username_password secrets.",
+			},
+			"secret_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The v4 UUID that uniquely identifies the secret.",
+			},
+			"interval": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The length of the secret rotation time interval.",
+			},
+			"unit": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{secretsmanagerv1.SecretPolicyRotationRotationUnitDayConst, secretsmanagerv1.SecretPolicyRotationRotationUnitMonthConst}),
+				Description:  "The units for the secret rotation time interval: day or month.",
+			},
+		}),
+	}
+}
+
+func resourceIBMSecretsManagerSecretRotationPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := getSecretsManagerClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretType := d.Get("secret_type").(string)
+	secretID := d.Get("secret_id").(string)
+	putPolicyOptions := &secretsmanagerv1.PutPolicyOptions{
+		SecretType: core.StringPtr(secretType),
+		ID:         core.StringPtr(secretID),
+		Metadata:   secretPolicyCollectionMetadata,
+		Resources: []secretsmanagerv1.SecretPolicyRotation{
+			{
+				Type: core.StringPtr(secretsmanagerv1.SecretPolicyRotationTypeApplicationVndIBMSecretsManagerSecretPolicyJSONConst),
+				Rotation: &secretsmanagerv1.SecretPolicyRotationRotation{
+					Interval: core.Int64Ptr(int64(d.Get("interval").(int))),
+					Unit:     core.StringPtr(d.Get("unit").(string)),
+				},
+			},
+		},
+		Policy: core.StringPtr(secretsmanagerv1.PutPolicyOptionsPolicyRotationConst),
+	}
+	_, response, err := secretsManagerClient.PutPolicyWithContext(context, putPolicyOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PutPolicyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PutPolicyWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", d.Get("instance_id").(string), secretType, secretID))
+
+	return resourceIBMSecretsManagerSecretRotationPolicyRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerSecretRotationPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	idParts, err := parseSecretsManagerID(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, secretType, secretID := idParts[0], idParts[1], idParts[2]
+	d.Set("instance_id", instanceID)
+
+	secretsManagerClient, err := getSecretsManagerClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getPolicyOptions := &secretsmanagerv1.GetPolicyOptions{
+		SecretType: core.StringPtr(secretType),
+		ID:         core.StringPtr(secretID),
+		Policy:     core.StringPtr(secretsmanagerv1.GetPolicyOptionsPolicyRotationConst),
+	}
+	policy, response, err := secretsManagerClient.GetPolicyWithContext(context, getPolicyOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Secret %s not found, removing its rotation policy from state", secretID)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetPolicyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetPolicyWithContext failed %s\n%s", err, response))
+	}
+
+	d.Set("secret_type", secretType)
+	d.Set("secret_id", secretID)
+	policies, ok := policy.(*secretsmanagerv1.GetSecretPoliciesOneOf)
+	if !ok || len(policies.Resources) == 0 || policies.Resources[0].Rotation == nil {
+		log.Printf("[WARN] Secret %s has no rotation policy, removing it from state", secretID)
+		d.SetId("")
+		return nil
+	}
+	rotation := policies.Resources[0].Rotation
+	if rotation.Interval != nil {
+		d.Set("interval", *rotation.Interval)
+	}
+	d.Set("unit", rotation.Unit)
+
+	return nil
+}
+
+func resourceIBMSecretsManagerSecretRotationPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The API does not remove rotation policies, the policy is deleted together with its secret
+	log.Printf("[WARN] The rotation policy of secret %s is only removed from state", d.Get("secret_id").(string))
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerSecretRotationPolicyBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-rotation-policy-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerSecretRotationPolicyConfig(name, 30, "day"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_rotation_policy.policy", "secret_type", "username_password"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_rotation_policy.policy", "interval", "30"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_rotation_policy.policy", "unit", "day"),
+					resource.TestCheckResourceAttrPair("ibm_secrets_manager_secret_rotation_policy.policy", "secret_id", "ibm_secrets_manager_username_password_secret.secret", "secret_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerSecretRotationPolicyConfig(name, 2, "month"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_rotation_policy.policy", "interval", "2"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_rotation_policy.policy", "unit", "month"),
+				),
+			},
+			{
+				ResourceName:      "ibm_secrets_manager_secret_rotation_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"endpoint_type",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerSecretRotationPolicyConfig(name string, interval int, unit string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_secret_group" "group" {
+			instance_id = "%[1]s"
+			name        = "%[2]s"
+		}
+
+		resource "ibm_secrets_manager_username_password_secret" "secret" {
+			instance_id     = "%[1]s"
+			name            = "%[2]s"
+			secret_group_id = ibm_secrets_manager_secret_group.group.secret_group_id
+			username        = "terraform"
+			password        = "Rotation-Passw0rd"
+			expiration_date = "2030-01-01T00:00:00Z"
+		}
+
+		resource "ibm_secrets_manager_secret_rotation_policy" "policy" {
+			instance_id = "%[1]s"
+			secret_id   = ibm_secrets_manager_username_password_secret.secret.secret_id
+			interval    = %[3]d
+			unit        = "%[4]s"
+		}
+	`, acc.SecretsManagerInstanceID, name, interval, unit)
+}
@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const usernamePasswordSecretType = secretsmanagerv1.CreateSecretOptionsSecretTypeUsernamePasswordConst
+
+func ResourceIBMSecretsManagerUsernamePasswordSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerUsernamePasswordSecretCreate,
+		ReadContext:   resourceIBMSecretsManagerUsernamePasswordSecretRead,
+		UpdateContext: resourceIBMSecretsManagerUsernamePasswordSecretUpdate,
+		DeleteContext: resourceIBMSecretsManagerSecretDelete(usernamePasswordSecretType),
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: secretsManagerSecretSchema(map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The username to assign to the secret.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password to assign to the secret. Changing it rotates the secret.",
+			},
+			"expiration_date": expirationDateSchema(),
+			"next_rotation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date that the secret is scheduled for automatic rotation. The date format follows RFC 3339.",
+			},
+		}),
+	}
+}
+
+func resourceIBMSecretsManagerUsernamePasswordSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := getSecretsManagerClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretResource := &secretsmanagerv1.SecretResourceUsernamePasswordSecretResource{
+		Name:     core.StringPtr(d.Get("name").(string)),
+		Labels:   flex.ExpandStringList(d.Get("labels").([]interface{})),
+		Username: core.StringPtr(d.Get("username").(string)),
+		Password: core.StringPtr(d.Get("password").(string)),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		secretResource.Description = core.StringPtr(description.(string))
+	}
+	if secretGroupID, ok := d.GetOk("secret_group_id"); ok {
+		secretResource.SecretGroupID = core.StringPtr(secretGroupID.(string))
+	}
+	secretResource.ExpirationDate, err = parseExpirationDate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretID, err := createSecretsManagerSecret(context, secretsManagerClient, usernamePasswordSecretType, secretResource)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(getSecretsManagerID(d.Get("instance_id").(string), secretID))
+
+	return resourceIBMSecretsManagerUsernamePasswordSecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerUsernamePasswordSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, diags := readSecretsManagerSecret(context, d, meta, usernamePasswordSecretType)
+	if diags != nil || secret == nil {
+		return diags
+	}
+
+	if secret.ExpirationDate != nil {
+		d.Set("expiration_date", secret.ExpirationDate.String())
+	}
+	if secret.NextRotationDate != nil {
+		d.Set("next_rotation_date", secret.NextRotationDate.String())
+	}
+	if secretData, ok := secret.SecretData.(map[string]interface{}); ok {
+		if username, ok := secretData["username"].(string); ok {
+			d.Set("username", username)
+		}
+		if password, ok := secretData["password"].(string); ok {
+			d.Set("password", password)
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerUsernamePasswordSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := getSecretsManagerClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateSecretsManagerSecretMetadata(context, d, secretsManagerClient, usernamePasswordSecretType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("password") {
+		action := &secretsmanagerv1.SecretActionOneOfRotateUsernamePasswordSecretBody{
+			Password: core.StringPtr(d.Get("password").(string)),
+		}
+		err = rotateSecretsManagerSecret(context, d, secretsManagerClient, usernamePasswordSecretType, action)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerUsernamePasswordSecretRead(context, d, meta)
+}
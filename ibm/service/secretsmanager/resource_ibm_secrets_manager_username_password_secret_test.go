@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerUsernamePasswordSecretBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-username-password-secret-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerUsernamePasswordSecretConfig(name, "first-Passw0rd"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_username_password_secret.secret", "username", "terraform"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_username_password_secret.secret", "password", "first-Passw0rd"),
+					resource.TestCheckResourceAttrSet("ibm_secrets_manager_username_password_secret.secret", "secret_group_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerUsernamePasswordSecretConfig(name, "rotated-Passw0rd"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_secrets_manager_username_password_secret.secret", "password", "rotated-Passw0rd"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_rotation_policy.policy", "interval", "30"),
+					resource.TestCheckResourceAttr("ibm_secrets_manager_secret_rotation_policy.policy", "unit", "day"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerUsernamePasswordSecretConfig(name, password string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_secret_group" "group" {
+			instance_id = "%[1]s"
+			name        = "%[2]s"
+		}
+
+		resource "ibm_secrets_manager_username_password_secret" "secret" {
+			instance_id     = "%[1]s"
+			name            = "%[2]s"
+			secret_group_id = ibm_secrets_manager_secret_group.group.secret_group_id
+			username        = "terraform"
+			password        = "%[3]s"
+			expiration_date = "2030-01-01T00:00:00Z"
+		}
+
+		resource "ibm_secrets_manager_secret_rotation_policy" "policy" {
+			instance_id = "%[1]s"
+			secret_id   = ibm_secrets_manager_username_password_secret.secret.secret_id
+			interval    = 30
+			unit        = "day"
+		}
+	`, acc.SecretsManagerInstanceID, name, password)
+}
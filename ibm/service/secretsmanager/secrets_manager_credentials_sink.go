@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	homedir "github.com/mitchellh/go-homedir"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CredentialsSinkSchema adds the optional destinations of the credentials generated by a resource to its schema.
+// When a destination is set, the resource does not store the credentials in state.
+func CredentialsSinkSchema(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	resourceSchema["credentials_output_path"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The path of the file the generated credentials are written to. When set, the credentials are not stored in state.",
+	}
+	resourceSchema["credentials_secrets_manager"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "The Secrets Manager arbitrary secret the generated credentials are stored in. When set, the credentials are not stored in state.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"instance_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Secrets Manager instance GUID",
+				},
+				"endpoint_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "public",
+					ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+					Description:  "Endpoint Type. 'public' or 'private'",
+				},
+				"secret_group_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The v4 UUID of the secret group of the secret. The secret is assigned to the `default` secret group when not set.",
+				},
+				"secret_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the secret.",
+				},
+				"secret_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The v4 UUID of the secret the credentials are stored in.",
+				},
+			},
+		},
+	}
+	return resourceSchema
+}
+
+// CredentialsSinkEnabled returns true when the generated credentials of the resource are not stored in state
+func CredentialsSinkEnabled(d *schema.ResourceData) bool {
+	_, outputPath := d.GetOk("credentials_output_path")
+	_, secretsManager := d.GetOk("credentials_secrets_manager")
+	return outputPath || secretsManager
+}
+
+// WriteCredentialsToSink writes the generated credentials of the resource to the destinations set in its configuration.
+// Credentials of a resource that already has a Secrets Manager secret are stored as a new version of the secret.
+func WriteCredentialsToSink(context context.Context, d *schema.ResourceData, meta interface{}, credentials string) error {
+	if path, ok := d.GetOk("credentials_output_path"); ok {
+		outputPath, err := homedir.Expand(path.(string))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error generating the credentials file path: %s", err)
+		}
+		if err = os.WriteFile(outputPath, []byte(credentials), 0600); err != nil {
+			return fmt.Errorf("[ERROR] Error writing the credentials to %s: %s", outputPath, err)
+		}
+		log.Printf("[INFO] Credentials written to %s", outputPath)
+	}
+
+	if sinks, ok := d.GetOk("credentials_secrets_manager"); ok {
+		sink := sinks.([]interface{})[0].(map[string]interface{})
+		secretsManagerClient, err := getSecretsManagerInstanceClient(meta, sink["instance_id"].(string), sink["endpoint_type"].(string))
+		if err != nil {
+			return err
+		}
+		// Rotated credentials are stored as a new version of the secret
+		if secretID := sink["secret_id"].(string); secretID != "" {
+			updateSecretOptions := &secretsmanagerv1.UpdateSecretOptions{
+				SecretType:        core.StringPtr(arbitrarySecretType),
+				ID:                core.StringPtr(secretID),
+				Action:            core.StringPtr(secretsmanagerv1.UpdateSecretOptionsActionRotateConst),
+				SecretActionOneOf: &secretsmanagerv1.SecretActionOneOfRotateArbitrarySecretBody{Payload: core.StringPtr(credentials)},
+			}
+			_, response, err := secretsManagerClient.UpdateSecretWithContext(context, updateSecretOptions)
+			if err != nil {
+				log.Printf("[DEBUG] UpdateSecretWithContext failed %s\n%s", err, response)
+				return fmt.Errorf("[ERROR] Error storing the rotated credentials in Secrets Manager: %s", err)
+			}
+			return nil
+		}
+		secretResource := &secretsmanagerv1.SecretResourceArbitrarySecretResource{
+			Name:    core.StringPtr(sink["secret_name"].(string)),
+			Payload: core.StringPtr(credentials),
+		}
+		if secretGroupID := sink["secret_group_id"].(string); secretGroupID != "" {
+			secretResource.SecretGroupID = core.StringPtr(secretGroupID)
+		}
+		secretID, err := createSecretsManagerSecret(context, secretsManagerClient, arbitrarySecretType, secretResource)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error storing the credentials in Secrets Manager: %s", err)
+		}
+		sink["secret_id"] = secretID
+		d.Set("credentials_secrets_manager", []interface{}{sink})
+	}
+	return nil
+}
+
+// DeleteCredentialsFromSink deletes the Secrets Manager secret holding the credentials of a deleted resource.
+// The credentials file is left in place.
+func DeleteCredentialsFromSink(context context.Context, d *schema.ResourceData, meta interface{}) error {
+	sinks, ok := d.GetOk("credentials_secrets_manager")
+	if !ok {
+		return nil
+	}
+	sink := sinks.([]interface{})[0].(map[string]interface{})
+	secretID := sink["secret_id"].(string)
+	if secretID == "" {
+		return nil
+	}
+	secretsManagerClient, err := getSecretsManagerInstanceClient(meta, sink["instance_id"].(string), sink["endpoint_type"].(string))
+	if err != nil {
+		return err
+	}
+	deleteSecretOptions := &secretsmanagerv1.DeleteSecretOptions{
+		SecretType: core.StringPtr(arbitrarySecretType),
+		ID:         core.StringPtr(secretID),
+	}
+	response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error deleting the credentials from Secrets Manager: %s", err)
+	}
+	return nil
+}
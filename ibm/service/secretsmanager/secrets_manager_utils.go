@@ -0,0 +1,350 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	secretCollectionMetadata = &secretsmanagerv1.CollectionMetadata{
+		CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretJSONConst),
+		CollectionTotal: core.Int64Ptr(1),
+	}
+	secretGroupCollectionMetadata = &secretsmanagerv1.CollectionMetadata{
+		CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretGroupJSONConst),
+		CollectionTotal: core.Int64Ptr(1),
+	}
+	secretPolicyCollectionMetadata = &secretsmanagerv1.CollectionMetadata{
+		CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretPolicyJSONConst),
+		CollectionTotal: core.Int64Ptr(1),
+	}
+)
+
+// getSecretsManagerClient returns a copy of the Secrets Manager client pointing to the endpoint of the instance_id of the resource
+func getSecretsManagerClient(d *schema.ResourceData, meta interface{}) (*secretsmanagerv1.SecretsManagerV1, error) {
+	return getSecretsManagerInstanceClient(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+}
+
+// getSecretsManagerInstanceClient returns a copy of the Secrets Manager client pointing to the endpoint of an instance
+func getSecretsManagerInstanceClient(meta interface{}, instanceID, endpointType string) (*secretsmanagerv1.SecretsManagerV1, error) {
+	bluemixSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	region := bluemixSession.Config.Region
+
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV1()
+	if err != nil {
+		return nil, err
+	}
+	rContollerClient, err := meta.(conns.ClientSession).ResourceControllerAPIV2()
+	if err != nil {
+		return nil, err
+	}
+
+	instanceData, err := rContollerClient.ResourceServiceInstanceV2().GetInstance(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	crnData := strings.Split(instanceData.Crn.String(), ":")
+	if crnData[4] != "secrets-manager" {
+		return nil, fmt.Errorf("[ERROR] Invalid or unsupported service Instance")
+	}
+
+	var smEndpointURL string
+	if endpointType == "private" {
+		smEndpointURL = "https://" + instanceID + ".private." + region + ".secrets-manager.appdomain.cloud"
+	} else {
+		smEndpointURL = "https://" + instanceID + "." + region + ".secrets-manager.appdomain.cloud"
+	}
+	// the client is shared by all the resources, each of them targets the endpoint of its own instance
+	client := secretsManagerClient.Clone()
+	client.Service.Options.URL = conns.EnvFallBack([]string{"IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT"}, smEndpointURL)
+	return client, nil
+}
+
+// secretsManagerResourceSchema adds the arguments shared by every Secrets Manager resource to the given schema
+func secretsManagerResourceSchema(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	resourceSchema["instance_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Secrets Manager instance GUID",
+	}
+	resourceSchema["endpoint_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+		Description:  "Endpoint Type. 'public' or 'private'",
+		Default:      "public",
+	}
+	return resourceSchema
+}
+
+// secretsManagerSecretSchema adds the arguments and attributes shared by every secret type to the given schema
+func secretsManagerSecretSchema(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	resourceSchema["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "A human-readable alias to assign to your secret.To protect your privacy, do not use personal data, such as your name or location, as an alias for your secret.",
+	}
+	resourceSchema["description"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "An extended description of your secret.To protect your privacy, do not use personal data, such as your name or location, as a description for your secret.",
+	}
+	resourceSchema["secret_group_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "The v4 UUID that uniquely identifies the secret group to assign to this secret.If you omit this parameter, your secret is assigned to the `default` secret group.",
+	}
+	resourceSchema["labels"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Labels that you can use to filter for secrets in your instance.Up to 30 labels can be created. Labels can be between 2-30 characters, including spaces.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	resourceSchema["secret_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The v4 UUID that uniquely identifies the secret.",
+	}
+	resourceSchema["crn"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The Cloud Resource Name (CRN) that uniquely identifies your Secrets Manager resource.",
+	}
+	resourceSchema["state"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The secret state based on NIST SP 800-57. States are integers and correspond to the Pre-activation = 0, Active = 1,  Suspended = 2, Deactivated = 3, and Destroyed = 5 values.",
+	}
+	resourceSchema["state_description"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "A text representation of the secret state.",
+	}
+	resourceSchema["creation_date"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The date the secret was created. The date format follows RFC 3339.",
+	}
+	resourceSchema["created_by"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The unique identifier for the entity that created the secret.",
+	}
+	resourceSchema["last_update_date"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Updates when the actual secret is modified. The date format follows RFC 3339.",
+	}
+	resourceSchema["versions_total"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The number of versions of the secret.",
+	}
+	return secretsManagerResourceSchema(resourceSchema)
+}
+
+// expirationDateSchema is the expiration date argument of the secret types that support one
+func expirationDateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsRFC3339Time,
+		Description:  "The date the secret material expires. The date format follows RFC 3339. If you omit it, the secret does not expire.",
+	}
+}
+
+func getSecretsManagerID(instanceID, id string) string {
+	return fmt.Sprintf("%s/%s", instanceID, id)
+}
+
+// parseSecretsManagerID returns the parts of an ID made of the instance ID followed by one or more IDs separated by '/'
+func parseSecretsManagerID(id string, parts int) ([]string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != parts {
+		return nil, fmt.Errorf("[ERROR] Incorrect ID %s: the ID must contain %d parts separated by '/', starting with the instance ID", id, parts)
+	}
+	return idParts, nil
+}
+
+func parseExpirationDate(d *schema.ResourceData) (*strfmt.DateTime, error) {
+	expirationDate, ok := d.GetOk("expiration_date")
+	if !ok {
+		return nil, nil
+	}
+	date, err := strfmt.ParseDateTime(expirationDate.(string))
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing expiration_date %s: %s", expirationDate, err)
+	}
+	return &date, nil
+}
+
+// createSecretsManagerSecret creates a secret and returns its ID
+func createSecretsManagerSecret(context context.Context, secretsManagerClient *secretsmanagerv1.SecretsManagerV1, secretType string, secretResource secretsmanagerv1.SecretResourceIntf) (string, error) {
+	createSecretOptions := &secretsmanagerv1.CreateSecretOptions{
+		SecretType: core.StringPtr(secretType),
+		Metadata:   secretCollectionMetadata,
+		Resources:  []secretsmanagerv1.SecretResourceIntf{secretResource},
+	}
+	createSecret, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
+		return "", fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response)
+	}
+	if len(createSecret.Resources) == 0 {
+		return "", fmt.Errorf("[ERROR] CreateSecretWithContext returned no %s secret", secretType)
+	}
+	secret, ok := createSecret.Resources[0].(*secretsmanagerv1.SecretResource)
+	if !ok || secret.ID == nil {
+		return "", fmt.Errorf("[ERROR] CreateSecretWithContext returned an unexpected %s secret", secretType)
+	}
+	return *secret.ID, nil
+}
+
+// readSecretsManagerSecret sets the attributes shared by every secret type and returns the secret.
+// A nil secret without error means the secret was removed from the state.
+func readSecretsManagerSecret(context context.Context, d *schema.ResourceData, meta interface{}, secretType string) (*secretsmanagerv1.SecretResource, diag.Diagnostics) {
+	idParts, err := parseSecretsManagerID(d.Id(), 2)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	instanceID, secretID := idParts[0], idParts[1]
+	d.Set("instance_id", instanceID)
+
+	secretsManagerClient, err := getSecretsManagerClient(d, meta)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	getSecretOptions := &secretsmanagerv1.GetSecretOptions{
+		SecretType: core.StringPtr(secretType),
+		ID:         core.StringPtr(secretID),
+	}
+	getSecret, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Secret %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil, nil
+		}
+		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
+		return nil, diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+	}
+	if len(getSecret.Resources) == 0 {
+		return nil, diag.FromErr(fmt.Errorf("[ERROR] GetSecretWithContext returned no %s secret %s", secretType, secretID))
+	}
+	secret, ok := getSecret.Resources[0].(*secretsmanagerv1.SecretResource)
+	if !ok {
+		return nil, diag.FromErr(fmt.Errorf("[ERROR] GetSecretWithContext returned an unexpected %s secret %s", secretType, secretID))
+	}
+
+	d.Set("secret_id", secretID)
+	d.Set("name", secret.Name)
+	d.Set("description", secret.Description)
+	d.Set("secret_group_id", secret.SecretGroupID)
+	d.Set("labels", secret.Labels)
+	d.Set("crn", secret.CRN)
+	d.Set("state", secret.State)
+	d.Set("state_description", secret.StateDescription)
+	d.Set("created_by", secret.CreatedBy)
+	if secret.CreationDate != nil {
+		d.Set("creation_date", secret.CreationDate.String())
+	}
+	if secret.LastUpdateDate != nil {
+		d.Set("last_update_date", secret.LastUpdateDate.String())
+	}
+	d.Set("versions_total", len(secret.Versions))
+	return secret, nil
+}
+
+// updateSecretsManagerSecretMetadata updates the metadata of a secret when one of them changed
+func updateSecretsManagerSecretMetadata(context context.Context, d *schema.ResourceData, secretsManagerClient *secretsmanagerv1.SecretsManagerV1, secretType string) error {
+	if !d.HasChanges("name", "description", "labels", "expiration_date", "ttl") {
+		return nil
+	}
+	secretMetadata := secretsmanagerv1.SecretMetadata{
+		Name:        core.StringPtr(d.Get("name").(string)),
+		Description: core.StringPtr(d.Get("description").(string)),
+		Labels:      flex.ExpandStringList(d.Get("labels").([]interface{})),
+	}
+	expirationDate, err := parseExpirationDate(d)
+	if err != nil {
+		return err
+	}
+	secretMetadata.ExpirationDate = expirationDate
+	if ttl, ok := d.GetOk("ttl"); ok {
+		secretMetadata.TTL = ttl.(string)
+	}
+
+	updateSecretMetadataOptions := &secretsmanagerv1.UpdateSecretMetadataOptions{
+		SecretType: core.StringPtr(secretType),
+		ID:         core.StringPtr(d.Get("secret_id").(string)),
+		Metadata:   secretCollectionMetadata,
+		Resources:  []secretsmanagerv1.SecretMetadata{secretMetadata},
+	}
+	_, response, err := secretsManagerClient.UpdateSecretMetadataWithContext(context, updateSecretMetadataOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateSecretMetadataWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("UpdateSecretMetadataWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+// rotateSecretsManagerSecret creates a new version of a secret
+func rotateSecretsManagerSecret(context context.Context, d *schema.ResourceData, secretsManagerClient *secretsmanagerv1.SecretsManagerV1, secretType string, action secretsmanagerv1.SecretActionOneOfIntf) error {
+	updateSecretOptions := &secretsmanagerv1.UpdateSecretOptions{
+		SecretType:        core.StringPtr(secretType),
+		ID:                core.StringPtr(d.Get("secret_id").(string)),
+		Action:            core.StringPtr(secretsmanagerv1.UpdateSecretOptionsActionRotateConst),
+		SecretActionOneOf: action,
+	}
+	_, response, err := secretsManagerClient.UpdateSecretWithContext(context, updateSecretOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateSecretWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("UpdateSecretWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+// resourceIBMSecretsManagerSecretDelete returns the delete function of the resource of a secret type
+func resourceIBMSecretsManagerSecretDelete(secretType string) schema.DeleteContextFunc {
+	return func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		secretsManagerClient, err := getSecretsManagerClient(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		deleteSecretOptions := &secretsmanagerv1.DeleteSecretOptions{
+			SecretType: core.StringPtr(secretType),
+			ID:         core.StringPtr(d.Get("secret_id").(string)),
+		}
+		response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed %s\n%s", err, response))
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
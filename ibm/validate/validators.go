@@ -4,6 +4,7 @@
 package validate
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -479,6 +480,32 @@ func validateIPorCIDR() schema.SchemaValidateFunc {
 	}
 }
 
+//ValidateIPorCIDRList validates a comma separated list of ip addresses, cidr addresses and ip address ranges like 10.0.0.1-10.0.0.10
+func ValidateIPorCIDRList(v interface{}, k string) (ws []string, errors []error) {
+	list := v.(string)
+	if strings.TrimSpace(list) == "" {
+		return
+	}
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if bounds := strings.Split(entry, "-"); len(bounds) == 2 {
+			start, end := net.ParseIP(strings.TrimSpace(bounds[0])), net.ParseIP(strings.TrimSpace(bounds[1]))
+			if start == nil || end == nil || (start.To4() == nil) != (end.To4() == nil) || bytes.Compare(start.To16(), end.To16()) > 0 {
+				errors = append(errors, fmt.Errorf(
+					"%q contains %q, which is not a valid ip address range",
+					k, entry))
+			}
+			continue
+		}
+		if _, errs := validateIPorCIDR()(entry, k); len(errs) != 0 {
+			errors = append(errors, fmt.Errorf(
+				"%q contains %q, which is not a valid ip address, cidr address or ip address range",
+				k, entry))
+		}
+	}
+	return
+}
+
 func ValidateSecurityRuleProtocol(v interface{}, k string) (ws []string, errors []error) {
 	validProtocols := map[string]bool{
 		"icmp": true,